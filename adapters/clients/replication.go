@@ -37,15 +37,37 @@ import (
 
 // ReplicationClient is to coordinate operations among replicas
 
-type replicationClient retryClient
+type replicationClient struct {
+	retryClient
+	codec clusterapi.VObjectCodec // encodes overwrite payloads
+}
+
+// ReplicationClientOption configures the replication client
+type ReplicationClientOption func(c *replicationClient)
 
-func NewReplicationClient(httpClient *http.Client) replica.Client {
-	return &replicationClient{
-		client:  httpClient,
-		retryer: newRetryer(),
+// WithVObjectCodec makes the client encode the objects sent to repair replicas
+// with codec instead of the default encoding. The codec must be registered on the
+// receiving nodes (see clusterapi.RegisterVObjectCodec).
+func WithVObjectCodec(codec clusterapi.VObjectCodec) ReplicationClientOption {
+	return func(c *replicationClient) {
+		c.codec = codec
 	}
 }
 
+func NewReplicationClient(httpClient *http.Client, opts ...ReplicationClientOption) replica.Client {
+	c := &replicationClient{
+		retryClient: retryClient{
+			client:  httpClient,
+			retryer: newRetryer(),
+		},
+		codec: clusterapi.IndicesPayloads.VersionedObjectList,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 // FetchObject fetches one object it exits
 func (c *replicationClient) FetchObject(ctx context.Context, host, index,
 	shard string, id strfmt.UUID, selectProps search.SelectProperties,
@@ -78,6 +100,21 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+// ShardReplicas asks host which nodes it believes replicate shard
+func (c *replicationClient) ShardReplicas(ctx context.Context,
+	host, index, shard string,
+) ([]string, error) {
+	var resp []string
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_replicas", nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*20, req, nil, &resp, 9)
+	return resp, err
+}
+
 func (c *replicationClient) DigestObjectsInTokenRange(ctx context.Context,
 	host, index, shard string, initialToken, finalToken uint64, limit int,
 ) (result []replica.RepairResponse, lastTokenRead uint64, err error) {
@@ -124,7 +161,7 @@ func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
 	var resp []replica.RepairResponse
-	body, err := clusterapi.IndicesPayloads.VersionedObjectList.Marshal(vobjects)
+	body, err := c.codec.Marshal(vobjects)
 	if err != nil {
 		return nil, fmt.Errorf("encode request: %w", err)
 	}
@@ -134,6 +171,7 @@ func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	if err != nil {
 		return resp, fmt.Errorf("create http request: %w", err)
 	}
+	req.Header.Set("content-type", c.codec.MIME())
 	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
 	return resp, err
 }
@@ -393,7 +431,7 @@ func (c *replicationClient) do(timeout time.Duration, req *http.Request, body []
 func (c *replicationClient) doCustomUnmarshal(timeout time.Duration,
 	req *http.Request, body []byte, decode func([]byte) error, numRetries int,
 ) (err error) {
-	return (*retryClient)(c).doWithCustomMarshaller(timeout, req, body, decode, successCode, numRetries)
+	return c.retryClient.doWithCustomMarshaller(timeout, req, body, decode, successCode, numRetries)
 }
 
 // backOff return a new random duration in the interval [d, 3d].
@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -584,6 +585,118 @@ func TestReplicationOverwriteObjects(t *testing.T) {
 	assert.Equal(t, expected[0].UpdateTime, resp[0].UpdateTime)
 }
 
+// prefixedVObjectCodec wraps the default encoding with a header
+type prefixedVObjectCodec struct{}
+
+func (prefixedVObjectCodec) MIME() string { return "application/vnd.weaviate.test.vobject" }
+
+func (prefixedVObjectCodec) Marshal(in []*objects.VObject) ([]byte, error) {
+	b, err := clusterapi.IndicesPayloads.VersionedObjectList.Marshal(in)
+	return append([]byte("v1"), b...), err
+}
+
+func (prefixedVObjectCodec) Unmarshal(in []byte) ([]*objects.VObject, error) {
+	if len(in) < 2 || string(in[:2]) != "v1" {
+		return nil, fmt.Errorf("missing header")
+	}
+	return clusterapi.IndicesPayloads.VersionedObjectList.Unmarshal(in[2:])
+}
+
+type fakeOverwriteReplicator struct {
+	*replica.RemoteReplicaIncoming
+	got []*objects.VObject
+}
+
+func (f *fakeOverwriteReplicator) OverwriteObjects(ctx context.Context, index, shard string,
+	vobjects []*objects.VObject,
+) ([]replica.RepairResponse, error) {
+	f.got = vobjects
+	return []replica.RepairResponse{{ID: vobjects[0].ID.String()}}, nil
+}
+
+func TestReplicationOverwriteObjectsWithCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := prefixedVObjectCodec{}
+	clusterapi.RegisterVObjectCodec(codec)
+
+	now := time.Now()
+	input := []*objects.VObject{
+		{
+			ID: UUID1,
+			LatestObject: &models.Object{
+				ID:                 UUID1,
+				Class:              "C1",
+				CreationTimeUnix:   now.UnixMilli(),
+				LastUpdateTimeUnix: now.Add(time.Hour).UnixMilli(),
+				Properties: map[string]interface{}{
+					"stringProp": "abc",
+				},
+			},
+			Vector:                  []float32{1, 2, 3, 4, 5},
+			LastUpdateTimeUnixMilli: now.Add(time.Hour).UnixMilli(),
+			StaleUpdateTime:         now.UnixMilli(),
+		},
+		{
+			ID:                      UUID2,
+			Deleted:                 true,
+			LastUpdateTimeUnixMilli: now.UnixMilli(),
+		},
+	}
+
+	shards := &fakeOverwriteReplicator{}
+	indices := clusterapi.NewReplicatedIndices(shards, nil, clusterapi.NewNoopAuthHandler(), func() bool { return false })
+	mux := http.NewServeMux()
+	mux.Handle("/replicas/indices/", indices.Indices())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := NewReplicationClient(server.Client(), WithVObjectCodec(codec)).(*replicationClient)
+	resp, err := c.OverwriteObjects(context.Background(), server.URL[7:], "C1", "S1", input)
+	require.Nil(t, err)
+	require.Len(t, resp, 1)
+	require.Len(t, shards.got, len(input))
+	for i := range input {
+		assert.Equal(t, input[i].ID, shards.got[i].ID)
+		assert.Equal(t, input[i].Deleted, shards.got[i].Deleted)
+		assert.Equal(t, input[i].LastUpdateTimeUnixMilli, shards.got[i].LastUpdateTimeUnixMilli)
+		assert.Equal(t, input[i].StaleUpdateTime, shards.got[i].StaleUpdateTime)
+		assert.Equal(t, input[i].Vector, shards.got[i].Vector)
+	}
+	assert.Equal(t, input[0].LatestObject.Properties, shards.got[0].LatestObject.Properties)
+}
+
+type fakeTopologyReplicator struct {
+	*replica.RemoteReplicaIncoming
+	nodes []string
+}
+
+func (f *fakeTopologyReplicator) ShardReplicas(ctx context.Context, index, shard string) ([]string, error) {
+	if shard != "S1" {
+		return nil, fmt.Errorf("shard %q not found", shard)
+	}
+	return f.nodes, nil
+}
+
+func TestReplicationShardReplicas(t *testing.T) {
+	t.Parallel()
+
+	shards := &fakeTopologyReplicator{nodes: []string{"N1", "N2"}}
+	indices := clusterapi.NewReplicatedIndices(shards, nil, clusterapi.NewNoopAuthHandler(), func() bool { return false })
+	mux := http.NewServeMux()
+	mux.Handle("/replicas/indices/", indices.Indices())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var c replica.TopologyReader = newReplicationClient(server.Client())
+	nodes, err := c.ShardReplicas(context.Background(), server.URL[7:], "C1", "S1")
+	require.Nil(t, err)
+	assert.Equal(t, shards.nodes, nodes)
+
+	_, err = c.ShardReplicas(context.Background(), server.URL[7:], "C1", "S2")
+	assert.ErrorContains(t, err, "not found")
+}
+
 func TestExpBackOff(t *testing.T) {
 	N := 200
 	av := time.Duration(0)
@@ -61,6 +61,7 @@ type replicator interface {
 		initialToken, finalToken uint64, limit int) (result []replica.RepairResponse, lastTokenRead uint64, err error)
 	HashTreeLevel(ctx context.Context, index, shard string,
 		level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	ShardReplicas(ctx context.Context, index, shard string) ([]string, error)
 }
 
 type localScaler interface {
@@ -86,6 +87,8 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_digest`)
 	regexObjectsDigestsInTokenRange = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/digestsInTokenRange`)
+	regxShardReplicas = regexp.MustCompile(`\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects/_replicas`)
 	regxHashTreeLevel = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/hashtree\/(` + l + `)`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
@@ -127,6 +130,14 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxShardReplicas.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getShardReplicas().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regexObjectsDigestsInTokenRange.MatchString(path):
@@ -433,6 +444,32 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getShardReplicas() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxShardReplicas.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		nodes, err := i.shards.ShardReplicas(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, "shard replicas: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(nodes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) getObjectsDigestsInTokenRange() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regexObjectsDigestsInTokenRange.FindStringSubmatch(r.URL.Path)
@@ -542,7 +579,14 @@ func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 			return
 		}
 
-		vobjs, err := IndicesPayloads.VersionedObjectList.Unmarshal(reqPayload)
+		ct := r.Header.Get("content-type")
+		codec, ok := LookupVObjectCodec(ct)
+		if !ok {
+			http.Error(w, "unsupported content type: "+ct, http.StatusUnsupportedMediaType)
+			return
+		}
+
+		vobjs, err := codec.Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal overwrite objects params from json: "+err.Error(),
 				http.StatusBadRequest)
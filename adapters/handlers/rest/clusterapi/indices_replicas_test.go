@@ -37,6 +37,7 @@ func TestMaintenanceModeReplicatedIndices(t *testing.T) {
 	}
 	indicesTestRequests := []indicesTestRequest{
 		{"GET", "/objects/_digest"},
+		{"GET", "/objects/_replicas"},
 		{"PUT", "/objects/_overwrite"},
 		{"DELETE", "/objects/deadbeef"},
 		{"PATCH", "/objects/deadbeef"},
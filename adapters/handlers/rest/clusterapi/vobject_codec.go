@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"sync"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// VObjectCodec encodes the versioned objects sent to replicas to repair them.
+// The MIME type is sent as content type of overwrite requests, so that
+// the receiving node decodes the payload with the matching codec.
+type VObjectCodec interface {
+	MIME() string
+	Marshal(in []*objects.VObject) ([]byte, error)
+	Unmarshal(in []byte) ([]*objects.VObject, error)
+}
+
+var vobjectCodecs = struct {
+	sync.RWMutex
+	m map[string]VObjectCodec
+}{m: map[string]VObjectCodec{
+	IndicesPayloads.VersionedObjectList.MIME(): IndicesPayloads.VersionedObjectList,
+}}
+
+// RegisterVObjectCodec makes codec available to decode incoming overwrite requests.
+// It must be registered on every node before any node sends payloads encoded with it.
+func RegisterVObjectCodec(codec VObjectCodec) {
+	vobjectCodecs.Lock()
+	defer vobjectCodecs.Unlock()
+	vobjectCodecs.m[codec.MIME()] = codec
+}
+
+// LookupVObjectCodec returns the codec registered for the given content type.
+// An empty content type stands for the default encoding.
+func LookupVObjectCodec(contentType string) (VObjectCodec, bool) {
+	if contentType == "" {
+		return IndicesPayloads.VersionedObjectList, true
+	}
+	vobjectCodecs.RLock()
+	defer vobjectCodecs.RUnlock()
+	codec, ok := vobjectCodecs.m[contentType]
+	return codec, ok
+}
@@ -366,6 +366,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		AvoidMMap:                      appState.ServerConfig.Config.AvoidMmap,
 		DisableLazyLoadShards:          appState.ServerConfig.Config.DisableLazyLoadShards,
 		ForceFullReplicasSearch:        appState.ServerConfig.Config.ForceFullReplicasSearch,
+		ReplicationLocalReads:          appState.ServerConfig.Config.ReplicationLocalReads,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -749,6 +750,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		// cancel module requests still in flight, e.g. vectorizations
+		if err := appState.Modules.Shutdown(ctx); err != nil {
+			appState.Logger.WithField("action", "shutdown_modules").
+				Errorf("failed to shut down modules: %s", err.Error())
+		}
+
 		if err := appState.ClusterService.Close(ctx); err != nil {
 			panic(err)
 		}
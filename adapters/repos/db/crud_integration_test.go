@@ -2435,6 +2435,27 @@ func TestIndexDigestObjects(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
+
+	t.Run("report shard generation", func(t *testing.T) {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		shd, err := idx.determineObjectShard(context.Background(), obj1.ID, "")
+		require.Nil(t, err)
+		idx.SetShardGeneration(shd, 2)
+
+		digests, err := idx.DigestObjects(context.Background(), shd, []strfmt.UUID{obj1.ID})
+		require.Nil(t, err)
+		require.Len(t, digests, 1)
+		assert.Equal(t, uint64(2), digests[0].Generation)
+
+		rep, err := idx.FetchObject(context.Background(), shd, obj1.ID)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), rep.Generation)
+
+		reps, err := idx.FetchObjects(context.Background(), shd, []strfmt.UUID{obj1.ID})
+		require.Nil(t, err)
+		require.Len(t, reps, 1)
+		assert.Equal(t, uint64(2), reps[0].Generation)
+	})
 }
 
 func findID(list []search.Result, id strfmt.UUID) (search.Result, bool) {
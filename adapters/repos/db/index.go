@@ -200,6 +200,10 @@ type Index struct {
 	shardTransferMutex shardTransfer
 	lastBackup         atomic.Pointer[BackupState]
 
+	// shardGenerations maps shard names to the generation reported by
+	// replication reads, see SetShardGeneration
+	shardGenerations sync.Map
+
 	// canceled when either Shutdown or Drop called
 	closingCtx    context.Context
 	closingCancel context.CancelFunc
@@ -249,9 +253,6 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		return nil, errors.Wrap(err, "failed to create new index")
 	}
 
-	repl := replica.NewReplicator(cfg.ClassName.String(),
-		sg, nodeResolver, string(cfg.DeletionStrategy), replicaClient, logger)
-
 	if cfg.QueryNestedRefLimit == 0 {
 		cfg.QueryNestedRefLimit = config.DefaultQueryNestedCrossReferenceLimit
 	}
@@ -265,7 +266,6 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		invertedIndexConfig:    invertedIndexConfig,
 		vectorIndexUserConfigs: vectorIndexUserConfigs,
 		stopwords:              sd,
-		replicator:             repl,
 		partitioningEnabled:    shardState.PartitioningEnabled,
 		remote:                 sharding.NewRemoteIndex(cfg.ClassName.String(), sg, nodeResolver, remoteClient),
 		metrics:                NewMetrics(logger, promMetrics, cfg.ClassName.String(), "n/a"),
@@ -276,6 +276,13 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		allocChecker:           allocChecker,
 		shardCreateLocks:       esync.NewKeyLocker(),
 	}
+	var finderOpts []replica.FinderOption
+	if cfg.ReplicationLocalReads {
+		finderOpts = append(finderOpts, replica.WithLocalReader(index))
+	}
+	index.replicator = replica.NewReplicator(cfg.ClassName.String(),
+		sg, nodeResolver, string(cfg.DeletionStrategy), replicaClient, logger,
+		finderOpts...)
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
 
 	index.initCycleCallbacks()
@@ -605,6 +612,7 @@ type IndexConfig struct {
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
+	ReplicationLocalReads          bool
 
 	TrackVectorDimensions bool
 }
@@ -2103,6 +2111,11 @@ func (i *Index) drop() error {
 	if err := i.stopCycleManagers(ctx, "drop"); err != nil {
 		return err
 	}
+	if i.replicator != nil {
+		if err := i.replicator.Close(ctx); err != nil {
+			return fmt.Errorf("drop: %w", err)
+		}
+	}
 
 	return os.RemoveAll(i.path())
 }
@@ -2211,6 +2224,11 @@ func (i *Index) Shutdown(ctx context.Context) error {
 	if err := i.stopCycleManagers(ctx, "shutdown"); err != nil {
 		return err
 	}
+	if i.replicator != nil {
+		if err := i.replicator.Close(ctx); err != nil {
+			return fmt.Errorf("shutdown: %w", err)
+		}
+	}
 
 	return nil
 }
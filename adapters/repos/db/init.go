@@ -101,6 +101,7 @@ func (db *DB) init(ctx context.Context) error {
 				AvoidMMap:                      db.config.AvoidMMap,
 				DisableLazyLoadShards:          db.config.DisableLazyLoadShards,
 				ForceFullReplicasSearch:        db.config.ForceFullReplicasSearch,
+				ReplicationLocalReads:          db.config.ReplicationLocalReads,
 				ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 				AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 				DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
@@ -126,6 +126,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			AvoidMMap:                      m.db.config.AvoidMMap,
 			DisableLazyLoadShards:          m.db.config.DisableLazyLoadShards,
 			ForceFullReplicasSearch:        m.db.config.ForceFullReplicasSearch,
+			ReplicationLocalReads:          m.db.config.ReplicationLocalReads,
 			ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
 			AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
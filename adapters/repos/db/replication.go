@@ -451,7 +451,22 @@ func (idx *Index) OverwriteObjects(ctx context.Context,
 		if err != nil {
 			return nil, fmt.Errorf("overwrite stale object: cannot get vectors: %w", err)
 		}
-		err = s.PutObject(ctx, storobj.FromObject(incomingObj, u.Vector, vectors, multiVectors))
+		// the object may have changed since it was read above, e.g. by a concurrent write
+		// of a more recent version, which must not be replaced by an older one
+		expected := currUpdateTime
+		if locallyDeleted {
+			expected = 0 // deleted objects are read as absent
+		}
+		err = s.PutObjectIfUnchanged(ctx, storobj.FromObject(incomingObj, u.Vector, vectors, multiVectors), &expected)
+		var changed objectChangedError
+		if errors.As(err, &changed) {
+			result = append(result, replica.RepairResponse{
+				ID:         id.String(),
+				UpdateTime: changed.updateTime,
+				Err:        "conflict",
+			})
+			continue
+		}
 		if err != nil {
 			r := replica.RepairResponse{
 				ID:  id.String(),
@@ -496,6 +511,7 @@ func (i *Index) DigestObjects(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("shard objects digest: %w", err)
 	}
+	generation := i.shardGeneration(shardName)
 
 	for j := range objs {
 		if objs[j] == nil {
@@ -514,14 +530,16 @@ func (i *Index) DigestObjects(ctx context.Context,
 				Deleted:    deleted,
 				UpdateTime: updateTime,
 				// TODO: use version when supported
-				Version: 0,
+				Version:    0,
+				Generation: generation,
 			}
 		} else {
 			result[j] = replica.RepairResponse{
 				ID:         objs[j].ID().String(),
 				UpdateTime: objs[j].LastUpdateTimeUnix(),
 				// TODO: use version when supported
-				Version: 0,
+				Version:    0,
+				Generation: generation,
 			}
 		}
 	}
@@ -529,6 +547,21 @@ func (i *Index) DigestObjects(ctx context.Context,
 	return
 }
 
+// SetShardGeneration sets the generation of shard reported by replication reads.
+// It is meant to be bumped whenever a new copy of the shard is installed on this
+// node, e.g. by a shard movement, so that readers expecting another generation
+// exclude this replica. The default generation 0 is accepted by every reader.
+func (i *Index) SetShardGeneration(shardName string, gen uint64) {
+	i.shardGenerations.Store(shardName, gen)
+}
+
+func (i *Index) shardGeneration(shardName string) uint64 {
+	if gen, ok := i.shardGenerations.Load(shardName); ok {
+		return gen.(uint64)
+	}
+	return 0
+}
+
 func (i *Index) IncomingDigestObjects(ctx context.Context,
 	shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
@@ -610,6 +643,7 @@ func (i *Index) FetchObject(ctx context.Context,
 			ID:                      id,
 			Deleted:                 deleted,
 			LastUpdateTimeUnixMilli: updateTime,
+			Generation:              i.shardGeneration(shardName),
 		}, nil
 	}
 
@@ -617,6 +651,7 @@ func (i *Index) FetchObject(ctx context.Context,
 		Object:                  obj,
 		ID:                      obj.ID(),
 		LastUpdateTimeUnixMilli: obj.LastUpdateTimeUnix(),
+		Generation:              i.shardGeneration(shardName),
 	}, nil
 }
 
@@ -640,6 +675,7 @@ func (i *Index) FetchObjects(ctx context.Context,
 	}
 
 	resp := make([]objects.Replica, len(ids))
+	generation := i.shardGeneration(shardName)
 
 	for j, obj := range objs {
 		if obj == nil {
@@ -657,12 +693,14 @@ func (i *Index) FetchObjects(ctx context.Context,
 				ID:                      ids[j],
 				Deleted:                 deleted,
 				LastUpdateTimeUnixMilli: updateTime,
+				Generation:              generation,
 			}
 		} else {
 			resp[j] = objects.Replica{
 				Object:                  obj,
 				ID:                      obj.ID(),
 				LastUpdateTimeUnixMilli: obj.LastUpdateTimeUnix(),
+				Generation:              generation,
 			}
 		}
 	}
@@ -206,6 +206,7 @@ type Config struct {
 	AvoidMMap                      bool
 	DisableLazyLoadShards          bool
 	ForceFullReplicasSearch        bool
+	ReplicationLocalReads          bool
 	Replication                    replication.GlobalConfig
 }
 
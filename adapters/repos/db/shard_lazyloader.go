@@ -213,6 +213,13 @@ func (l *LazyLoadShard) PutObject(ctx context.Context, object *storobj.Object) e
 	return l.shard.PutObject(ctx, object)
 }
 
+func (l *LazyLoadShard) PutObjectIfUnchanged(ctx context.Context, object *storobj.Object, updateTime *int64) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.PutObjectIfUnchanged(ctx, object, updateTime)
+}
+
 func (l *LazyLoadShard) PutObjectBatch(ctx context.Context, objects []*storobj.Object) []error {
 	if err := l.Load(ctx); err != nil {
 		return []error{err}
@@ -87,6 +87,47 @@ func TestShard_UpdateStatus(t *testing.T) {
 	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
 }
 
+func TestShard_PutObjectIfUnchanged(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className)
+
+	obj := testObject(className)
+	obj.Object.LastUpdateTimeUnix = 1
+	absent := int64(0)
+	require.Nil(t, shd.PutObjectIfUnchanged(ctx, obj, &absent))
+
+	t.Run("precondition fails", func(t *testing.T) {
+		// e.g. a repair of a stale replica racing with the write of a newer version
+		stale := testObject(className)
+		stale.Object.ID = obj.ID()
+		stale.Object.LastUpdateTimeUnix = 2
+		err := shd.PutObjectIfUnchanged(ctx, stale, &absent)
+		var changed objectChangedError
+		require.ErrorAs(t, err, &changed)
+		assert.Equal(t, int64(1), changed.updateTime)
+
+		found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), found.LastUpdateTimeUnix())
+	})
+
+	t.Run("precondition holds", func(t *testing.T) {
+		fresh := testObject(className)
+		fresh.Object.ID = obj.ID()
+		fresh.Object.LastUpdateTimeUnix = 3
+		current := int64(1)
+		require.Nil(t, shd.PutObjectIfUnchanged(ctx, fresh, &current))
+
+		found, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), found.LastUpdateTimeUnix())
+	})
+
+	require.Nil(t, idx.drop())
+	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+}
+
 func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	amount := 10000
 	sizePerValue := 8
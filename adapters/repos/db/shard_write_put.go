@@ -33,6 +33,24 @@ import (
 )
 
 func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
+	return s.PutObjectIfUnchanged(ctx, object, nil)
+}
+
+// objectChangedError is returned by a conditional put if the stored object
+// is no longer at the expected update time
+type objectChangedError struct {
+	updateTime int64 // of the stored object, 0 if absent
+}
+
+func (e objectChangedError) Error() string {
+	return fmt.Sprintf("object changed: stored update time %d", e.updateTime)
+}
+
+// PutObjectIfUnchanged puts object like PutObject, provided that the stored object
+// has still the update time *updateTime (0 meaning absent or deleted) when it is replaced.
+// Otherwise the object is not put and an objectChangedError is returned.
+// A nil updateTime puts the object unconditionally.
+func (s *Shard) PutObjectIfUnchanged(ctx context.Context, object *storobj.Object, updateTime *int64) error {
 	s.activityTracker.Add(1)
 	if err := s.isReadOnly(); err != nil {
 		return err
@@ -41,11 +59,16 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 	if err != nil {
 		return err
 	}
-	return s.putOne(ctx, uid, object)
+	return s.putOneIf(ctx, uid, object, updateTime)
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
-	status, err := s.putObjectLSM(object, uuid)
+	return s.putOneIf(ctx, uuid, object, nil)
+}
+
+// putOneIf puts object if the stored object is at update time *updateTime, see PutObjectIfUnchanged
+func (s *Shard) putOneIf(ctx context.Context, uuid []byte, object *storobj.Object, updateTime *int64) error {
+	status, err := s.putObjectLSMIf(object, uuid, updateTime)
 	if err != nil {
 		return errors.Wrap(err, "store object in LSM store")
 	}
@@ -233,6 +256,13 @@ func fetchObject(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, error)
 }
 
 func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
+) (status objectInsertStatus, err error) {
+	return s.putObjectLSMIf(obj, idBytes, nil)
+}
+
+// putObjectLSMIf stores obj like putObjectLSM if the stored object is at update time
+// *updateTime. The precondition is checked under the same lock as the write.
+func (s *Shard) putObjectLSMIf(obj *storobj.Object, idBytes []byte, updateTime *int64,
 ) (status objectInsertStatus, err error) {
 	before := time.Now()
 	defer s.metrics.PutObject(before)
@@ -277,6 +307,15 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		if err != nil {
 			return err
 		}
+		if updateTime != nil {
+			var prevTime int64
+			if prevObj != nil {
+				prevTime = prevObj.LastUpdateTimeUnix()
+			}
+			if prevTime != *updateTime {
+				return objectChangedError{updateTime: prevTime}
+			}
+		}
 
 		status, err = s.determineInsertStatus(prevObj, obj)
 		if err != nil {
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interval
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the delays to wait between consecutive retries.
+// Implementations are not safe for concurrent use, each sequence of
+// retries should use its own instance.
+type Backoff interface {
+	// Next returns the delay to wait before the next retry
+	Next() time.Duration
+	// Reset restarts the sequence of delays
+	Reset()
+}
+
+// ConstantBackoff waits the same delay before each retry
+type ConstantBackoff struct {
+	delay time.Duration
+}
+
+// NewConstantBackoff returns a Backoff always waiting delay
+func NewConstantBackoff(delay time.Duration) *ConstantBackoff {
+	return &ConstantBackoff{delay: delay}
+}
+
+func (b *ConstantBackoff) Next() time.Duration { return b.delay }
+
+func (b *ConstantBackoff) Reset() {}
+
+// ExponentialBackoff multiplies the delay by a constant factor after each retry
+type ExponentialBackoff struct {
+	initial    time.Duration
+	max        time.Duration
+	multiplier float64
+	current    time.Duration
+}
+
+// NewExponentialBackoff returns a Backoff starting at initial and multiplying the
+// delay by multiplier after each retry, up to max. A max <= 0 means no upper bound.
+func NewExponentialBackoff(initial, max time.Duration, multiplier float64) *ExponentialBackoff {
+	return &ExponentialBackoff{initial: initial, max: max, multiplier: multiplier, current: initial}
+}
+
+func (b *ExponentialBackoff) Next() time.Duration {
+	delay := b.current
+	if b.max > 0 && delay > b.max {
+		delay = b.max
+	}
+	b.current = time.Duration(float64(delay) * b.multiplier)
+	return delay
+}
+
+func (b *ExponentialBackoff) Reset() {
+	b.current = b.initial
+}
+
+// JitteredBackoff randomizes the delays of another Backoff,
+// so that clients retrying at the same time spread their retries
+type JitteredBackoff struct {
+	backoff Backoff
+	factor  float64
+	rand    *rand.Rand
+}
+
+// NewJitteredBackoff returns a Backoff whose delays are drawn uniformly from
+// [d*(1-factor), d*(1+factor)], where d is the delay returned by backoff.
+// factor is in the range [0, 1].
+func NewJitteredBackoff(backoff Backoff, factor float64) *JitteredBackoff {
+	return &JitteredBackoff{
+		backoff: backoff,
+		factor:  factor,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (b *JitteredBackoff) Next() time.Duration {
+	delay := float64(b.backoff.Next())
+	return time.Duration(delay * (1 - b.factor + 2*b.factor*b.rand.Float64()))
+}
+
+func (b *JitteredBackoff) Reset() {
+	b.backoff.Reset()
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sequence(b Backoff, n int) []time.Duration {
+	xs := make([]time.Duration, n)
+	for i := range xs {
+		xs[i] = b.Next()
+	}
+	return xs
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Run("constant", func(t *testing.T) {
+		b := NewConstantBackoff(time.Second)
+		assert.Equal(t, []time.Duration{time.Second, time.Second, time.Second}, sequence(b, 3))
+		b.Reset()
+		assert.Equal(t, time.Second, b.Next())
+	})
+
+	t.Run("exponential", func(t *testing.T) {
+		b := NewExponentialBackoff(100*time.Millisecond, time.Second, 2)
+		want := []time.Duration{
+			100 * time.Millisecond,
+			200 * time.Millisecond,
+			400 * time.Millisecond,
+			800 * time.Millisecond,
+			time.Second,
+			time.Second,
+		}
+		assert.Equal(t, want, sequence(b, 6))
+		b.Reset()
+		assert.Equal(t, want[:2], sequence(b, 2))
+	})
+
+	t.Run("exponential without upper bound", func(t *testing.T) {
+		b := NewExponentialBackoff(time.Second, 0, 3)
+		assert.Equal(t, []time.Duration{time.Second, 3 * time.Second, 9 * time.Second}, sequence(b, 3))
+	})
+
+	t.Run("jittered", func(t *testing.T) {
+		b := NewJitteredBackoff(NewExponentialBackoff(100*time.Millisecond, 0, 2), 0.5)
+		for i, d := range sequence(b, 8) {
+			base := 100 * time.Millisecond << i
+			assert.GreaterOrEqual(t, d, base/2)
+			assert.LessOrEqual(t, d, base*3/2)
+		}
+		b.Reset()
+		d := b.Next()
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	})
+
+	t.Run("jittered without jitter", func(t *testing.T) {
+		b := NewJitteredBackoff(NewConstantBackoff(time.Second), 0)
+		assert.Equal(t, []time.Duration{time.Second, time.Second}, sequence(b, 2))
+	})
+}
@@ -55,6 +55,13 @@ type ModuleDependency interface {
 	InitDependency(modules []Module) error
 }
 
+// ModuleWithShutdown is implemented by modules which hold resources, e.g.
+// requests in flight, to be released when the server shuts down
+type ModuleWithShutdown interface {
+	Module
+	Shutdown(ctx context.Context) error
+}
+
 type Dependency[T dto.Embedding] interface {
 	ModuleName() string
 	Argument() string
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/modules/text2vec-weaviate/ent"
+)
+
+// ModelInfo describes an embedding model served by the Weaviate embedding service
+type ModelInfo struct {
+	Model string `json:"model"`
+	// Dimensions is the size of the vectors produced by the model
+	Dimensions int `json:"dimensions"`
+	// MaxInputTokens is the maximum length of a single input, 0 if unknown
+	MaxInputTokens int `json:"max_input_tokens,omitempty"`
+	// MaxBatchSize is the maximum number of inputs per request, 0 if unknown
+	MaxBatchSize int `json:"max_batch_size,omitempty"`
+}
+
+// DescribeModel returns the dimensions and limits of model, so that schema
+// setup can check compatibility before indexing. If the service does not
+// expose metadata for the model, the dimensions are inferred from a probe
+// embedding and the limits are left unknown.
+func (v *vectorizer) DescribeModel(ctx context.Context, model string) (ModelInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", v.getWeaviateModelURL(ctx, model), nil)
+	if err != nil {
+		return ModelInfo{}, errors.Wrap(err, "create GET request")
+	}
+	if err := v.setAuthHeaders(ctx, req); err != nil {
+		return ModelInfo{}, err
+	}
+	req.Header.Add("Request-Source", "unspecified:weaviate")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return ModelInfo{}, errors.Wrap(err, "send GET request")
+	}
+	defer closeBody(res.Body)
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return ModelInfo{}, errors.Wrap(err, "read response body")
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return v.probeModel(ctx, model)
+	}
+	if res.StatusCode > 200 {
+		errorMessage := getErrorMessage(res.StatusCode, string(bodyBytes), "Weaviate embed API error: %d %s")
+		return ModelInfo{}, errors.New(errorMessage)
+	}
+
+	var info ModelInfo
+	if err := json.Unmarshal(bodyBytes, &info); err != nil {
+		return ModelInfo{}, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
+	}
+	if info.Model == "" {
+		info.Model = model
+	}
+	return info, nil
+}
+
+// probeModel infers the dimensions of model from the embedding of a short text
+func (v *vectorizer) probeModel(ctx context.Context, model string) (ModelInfo, error) {
+	res, _, _, err := v.vectorize(ctx, []string{"probe"}, model, "", "", false, ent.VectorizationConfig{Model: model})
+	if err != nil {
+		return ModelInfo{}, errors.Wrap(err, "probe model")
+	}
+	return ModelInfo{Model: model, Dimensions: res.Dimensions}, nil
+}
+
+// Prime sends a tiny embed request for model and returns once the model has
+// responded. It is meant to trigger the loading of cold models before real
+// traffic arrives, to avoid the latency of the first request during imports.
+func (v *vectorizer) Prime(ctx context.Context, model string) error {
+	_, _, _, err := v.vectorize(ctx, []string{"prime"}, model, "", "", false, ent.VectorizationConfig{Model: model})
+	if err != nil {
+		return errors.Wrapf(err, "prime model %s", model)
+	}
+	return nil
+}
+
+func (v *vectorizer) getWeaviateModelURL(ctx context.Context, model string) string {
+	return v.urlBuilder.modelURL(v.getWeaviateBaseURL(ctx, ""), model)
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/modules/text2vec-weaviate/ent"
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizeInput trims whitespace from texts, converts them to Unicode NFC and,
+// if requested, to lower case. Texts which are equal after normalization are
+// only returned once, positions maps each input text to its normalized text.
+func normalizeInput(input []string, lowercase bool) (texts []string, positions []int) {
+	texts = make([]string, 0, len(input))
+	positions = make([]int, len(input))
+	seen := make(map[string]int, len(input))
+	for i, text := range input {
+		text = norm.NFC.String(strings.TrimSpace(text))
+		if lowercase {
+			text = strings.ToLower(text)
+		}
+		pos, ok := seen[text]
+		if !ok {
+			pos = len(texts)
+			seen[text] = pos
+			texts = append(texts, text)
+		}
+		positions[i] = pos
+	}
+	return texts, positions
+}
+
+var (
+	escapeReplacer  = strings.NewReplacer("\n", `\n`, "\r", `\r`, "\x00", "")
+	replaceReplacer = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\x00", "")
+)
+
+// sanitizeInput escapes or replaces, depending on mode, the newlines and null bytes
+// some embed gateways interpret specially. The input slice is not modified.
+func sanitizeInput(input []string, mode string) []string {
+	r := escapeReplacer
+	if mode == ent.SanitizeReplace {
+		r = replaceReplacer
+	}
+	texts := make([]string, len(input))
+	for i, text := range input {
+		texts[i] = r.Replace(text)
+	}
+	return texts
+}
+
+// expandEmbeddings maps the embeddings of normalized texts back to the input texts
+func expandEmbeddings(embeddings [][]float32, positions []int) [][]float32 {
+	vectors := make([][]float32, len(positions))
+	for i, pos := range positions {
+		vectors[i] = embeddings[pos]
+	}
+	return vectors
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+
+	"github.com/weaviate/weaviate/entities/interval"
+)
+
+// Option configures module level behaviour of the embed client
+type Option func(v *vectorizer)
+
+// WithGzipMinBytes enables gzip compression of request bodies which are at least
+// minBytes long. Bodies are only compressed once the embed gateway has advertised
+// gzip support through its Accept-Encoding response header. A value <= 0 disables compression.
+func WithGzipMinBytes(minBytes int) Option {
+	return func(v *vectorizer) {
+		v.gzipMinBytes = minBytes
+	}
+}
+
+// WithMaxPayloadBytes limits the size of request bodies sent to the embed gateway.
+// Larger requests fail with errPayloadTooLarge before being sent, unless auto-split
+// is enabled. A value <= 0 disables the limit.
+func WithMaxPayloadBytes(maxBytes int) Option {
+	return func(v *vectorizer) {
+		v.maxPayloadBytes = maxBytes
+	}
+}
+
+// WithPayloadAutoSplit splits requests exceeding the maximum payload size into
+// smaller requests instead of failing. A single oversized text still fails.
+func WithPayloadAutoSplit(split bool) Option {
+	return func(v *vectorizer) {
+		v.autoSplit = split
+	}
+}
+
+// WithMaxBatchChars batches the texts of a call by their cumulative length, so that
+// each request to the embed gateway holds at most maxChars characters, e.g. to stay
+// within the model's context limit. Many short texts are sent in one request, long
+// texts in several. A single text longer than maxChars is sent on its own.
+// A value <= 0 disables batching.
+func WithMaxBatchChars(maxChars int) Option {
+	return func(v *vectorizer) {
+		v.maxBatchChars = maxChars
+	}
+}
+
+// WithMalformedResponseRetries makes the embed client check that the gateway returns
+// exactly one non-empty embedding per input text. Malformed responses are retried up
+// to retries times before failing with errMalformedEmbedding.
+func WithMalformedResponseRetries(retries int) Option {
+	return func(v *vectorizer) {
+		v.validateEmbeddings = true
+		v.malformedRetries = max(retries, 0)
+	}
+}
+
+// WithVectorValidation makes the embed client reject responses holding a vector with
+// NaN or infinite values, or whose norm is zero or below minNorm, with errInvalidEmbedding.
+// Such vectors denote a failing model and would otherwise be indexed silently.
+func WithVectorValidation(minNorm float64) Option {
+	return func(v *vectorizer) {
+		v.validateVectors = true
+		v.minVectorNorm = minNorm
+	}
+}
+
+// WithBase64Encoding makes the embed client request embeddings as base64 strings
+// of packed little-endian float32 values, which are smaller over the wire than
+// JSON numbers. Embeddings returned as JSON numbers are still accepted.
+func WithBase64Encoding(enabled bool) Option {
+	return func(v *vectorizer) {
+		v.base64Encoding = enabled
+	}
+}
+
+// WithMsgpackResponses makes the embed client ask the gateway for msgpack encoded
+// responses, which are faster to decode than JSON for large batches. JSON responses
+// are still accepted, the format is chosen by the response's Content-Type.
+func WithMsgpackResponses(enabled bool) Option {
+	return func(v *vectorizer) {
+		v.acceptMsgpack = enabled
+	}
+}
+
+// WithRetryBackoff makes the embed client wait between retries of a request, using
+// a backoff created by newBackoff for each request. Without it retries are immediate.
+func WithRetryBackoff(newBackoff func() interval.Backoff) Option {
+	return func(v *vectorizer) {
+		v.newBackoff = newBackoff
+	}
+}
+
+// WithRequestCoalescing makes concurrent identical requests, i.e. for the same texts
+// and model sent with the same credentials, share a single call to the embed gateway.
+// Unlike caching, it does not reuse the results of completed requests. Requests
+// served by a shared call are counted as cache hits in the module's metrics.
+func WithRequestCoalescing(enabled bool) Option {
+	return func(v *vectorizer) {
+		v.coalesce = enabled
+	}
+}
+
+// WithOrganization attributes embeddings requests to organization and project, e.g. for
+// the quotas of a gateway, by sending them in the X-Weaviate-Organization and
+// X-Weaviate-Project headers. The request headers of the same name take precedence.
+// Empty values are not sent.
+func WithOrganization(organization, project string) Option {
+	return func(v *vectorizer) {
+		v.organization = organization
+		v.project = project
+	}
+}
+
+// WithRootCAs makes the embed client trust the certificate authorities in pool,
+// e.g. to reach an internal gateway using a self-signed certificate.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(v *vectorizer) {
+		v.tlsConfig().RootCAs = pool
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the embed gateway's certificate.
+// It is meant for internal endpoints only, as it makes connections
+// susceptible to man-in-the-middle attacks. Prefer WithRootCAs where possible.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(v *vectorizer) {
+		v.tlsConfig().InsecureSkipVerify = skip
+	}
+}
+
+// tlsConfig returns the TLS configuration of the embed client's own transport
+func (v *vectorizer) tlsConfig() *tls.Config {
+	transport, ok := v.httpClient.Transport.(*http.Transport)
+	if !ok {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+		v.httpClient.Transport = transport
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	return transport.TLSClientConfig
+}
@@ -11,7 +11,12 @@
 
 package clients
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
+
+const modelsPath = "/v1/embeddings/models/"
 
 type weaviateEmbedUrlBuilder struct {
 	origin   string
@@ -31,3 +36,10 @@ func (c *weaviateEmbedUrlBuilder) url(baseURL string) string {
 	}
 	return fmt.Sprintf("%s%s", c.origin, c.pathMask)
 }
+
+func (c *weaviateEmbedUrlBuilder) modelURL(baseURL, model string) string {
+	if baseURL == "" {
+		baseURL = c.origin
+	}
+	return fmt.Sprintf("%s%s%s", baseURL, modelsPath, url.PathEscape(model))
+}
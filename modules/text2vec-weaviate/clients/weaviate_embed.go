@@ -13,32 +13,64 @@ package clients
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"mime"
 	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/interval"
 	"github.com/weaviate/weaviate/entities/moduletools"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/vmihailenco/msgpack/v5"
 	"github.com/weaviate/weaviate/modules/text2vec-weaviate/ent"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
 	DefaultRPM = 10000
 	DefaultTPM = 10_000_000
+
+	// maxDrainBytes is the maximum number of unread response bytes discarded
+	// to reuse a connection, larger leftovers close the connection instead
+	maxDrainBytes = 64 << 10
+
+	// metricsLabel is the vectorizer label of the module's metrics, it matches
+	// the label used by the batch vectorizer for request durations
+	metricsLabel = "text2vec-weaviate"
+)
+
+var (
+	errPayloadTooLarge    = errors.New("payload too large")
+	errMalformedEmbedding = errors.New("malformed embeddings response")
+	errInvalidEmbedding   = errors.New("invalid embedding")
+	errShutdown           = errors.New("vectorizer is shut down")
 )
 
 type embeddingsRequest struct {
 	Texts         []string `json:"texts"`
 	IsSearchQuery bool     `json:"is_search_query,omitempty"`
 	Dimensions    *int64   `json:"dimensions,omitempty"`
+	// EncodingFormat is "base64" to receive packed float32 vectors, empty means JSON numbers
+	EncodingFormat string `json:"encoding_format,omitempty"`
 }
 
 type embeddingsResponse struct {
@@ -46,6 +78,13 @@ type embeddingsResponse struct {
 	Metadata   metadata    `json:"metadata,omitempty"`
 }
 
+// base64EmbeddingsResponse is the response to a request made with encoding format base64.
+// Each embedding is either a base64 string or an array of numbers, if the gateway ignored the format.
+type base64EmbeddingsResponse struct {
+	Embeddings []json.RawMessage `json:"embeddings,omitempty"`
+	Metadata   metadata          `json:"metadata,omitempty"`
+}
+
 type embeddingsResponseError struct {
 	Detail string `json:"detail"`
 }
@@ -62,10 +101,53 @@ type vectorizer struct {
 	httpClient *http.Client
 	urlBuilder *weaviateEmbedUrlBuilder
 	logger     logrus.FieldLogger
+	// gzipMinBytes is the minimum body size to compress, 0 disables compression
+	gzipMinBytes int
+	// gzipSupported is set once the gateway advertised gzip support
+	gzipSupported atomic.Bool
+	// maxPayloadBytes is the maximum request body size, 0 means unlimited
+	maxPayloadBytes int
+	// autoSplit splits requests exceeding maxPayloadBytes instead of failing
+	autoSplit bool
+	// maxBatchChars is the maximum number of characters per request, 0 means unlimited
+	maxBatchChars int
+	// validateEmbeddings checks that one non-empty embedding is returned per input
+	validateEmbeddings bool
+	// malformedRetries is the number of times a malformed response is retried
+	malformedRetries int
+	// base64Encoding requests embeddings as base64 packed float32 values
+	base64Encoding bool
+	// validateVectors rejects responses holding vectors with NaN or infinite
+	// values or a norm below minVectorNorm
+	validateVectors bool
+	minVectorNorm   float64
+	// acceptMsgpack asks for msgpack encoded responses
+	acceptMsgpack bool
+	// newBackoff, if not nil, creates the backoff waited between retries
+	newBackoff func() interval.Backoff
+	// organization and project are sent for quota attribution unless overridden
+	// by the request headers, empty values are not sent
+	organization string
+	project      string
+	// coalesce shares a single upstream call between concurrent identical requests
+	coalesce bool
+	inflight singleflight.Group
+	// joined, if not nil, is called once a request has started or joined a shared call
+	joined func()
+	// tlsClients are the clients of classes overriding the TLS settings of httpClient
+	tlsMu      sync.Mutex
+	tlsClients map[tlsSettings]*http.Client
+
+	// stopCtx is cancelled on shutdown to cancel the requests in flight
+	stopCtx context.Context
+	stop    context.CancelFunc
+	mu      sync.Mutex // guards stopCtx, stopped and the additions to running
+	stopped bool
+	running sync.WaitGroup
 }
 
-func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *vectorizer {
-	return &vectorizer{
+func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger, opts ...Option) *vectorizer {
+	v := &vectorizer{
 		apiKey: apiKey,
 		httpClient: &http.Client{
 			Timeout: timeout,
@@ -73,11 +155,20 @@ func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *vecto
 		urlBuilder: newWeaviateEmbedUrlBuilder(),
 		logger:     logger,
 	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 func (v *vectorizer) Vectorize(ctx context.Context, input []string,
 	cfg moduletools.ClassConfig,
 ) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
+	ctx, done, err := v.begin(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer done()
 	config := v.getVectorizationConfig(cfg)
 	return v.vectorize(ctx, input, config.Model, config.Truncate, config.BaseURL, false, config)
 }
@@ -85,11 +176,76 @@ func (v *vectorizer) Vectorize(ctx context.Context, input []string,
 func (v *vectorizer) VectorizeQuery(ctx context.Context, input []string,
 	cfg moduletools.ClassConfig,
 ) (*modulecomponents.VectorizationResult[[]float32], error) {
+	ctx, done, err := v.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	config := v.getVectorizationConfig(cfg)
 	res, _, _, err := v.vectorize(ctx, input, config.Model, config.Truncate, config.BaseURL, true, config)
 	return res, err
 }
 
+// begin registers a call in flight. The returned context is also cancelled on shutdown,
+// done must be called once the call has returned.
+func (v *vectorizer) begin(ctx context.Context) (context.Context, func(), error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.stopped {
+		return ctx, nil, errShutdown
+	}
+	v.running.Add(1)
+	ctx, cancel := context.WithCancelCause(ctx)
+	unregister := context.AfterFunc(v.stopContext(), func() { cancel(errShutdown) })
+	return ctx, func() {
+		unregister()
+		cancel(nil)
+		v.running.Done()
+	}, nil
+}
+
+// stopContext returns the context cancelled on shutdown, v.mu must be held
+func (v *vectorizer) stopContext() context.Context {
+	if v.stopCtx == nil {
+		v.stopCtx, v.stop = context.WithCancel(context.Background())
+	}
+	return v.stopCtx
+}
+
+// Shutdown stops accepting new calls, cancels the calls in flight and waits
+// for them to return or for ctx to be done, whichever happens first.
+func (v *vectorizer) Shutdown(ctx context.Context) error {
+	v.mu.Lock()
+	v.stopped = true
+	v.stopContext()
+	v.stop()
+	v.mu.Unlock()
+
+	drained := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		v.running.Wait()
+		close(drained)
+	}, v.logger)
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "wait for embeddings requests in flight")
+	}
+}
+
+// waitFor blocks for d or until ctx is done
+func waitFor(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func (v *vectorizer) getVectorizationConfig(cfg moduletools.ClassConfig) ent.VectorizationConfig {
 	icheck := ent.NewClassSettings(cfg)
 	return ent.VectorizationConfig{
@@ -97,79 +253,525 @@ func (v *vectorizer) getVectorizationConfig(cfg moduletools.ClassConfig) ent.Vec
 		BaseURL:    icheck.BaseURL(),
 		Truncate:   icheck.Truncate(),
 		Dimensions: icheck.Dimensions(),
+
+		NormalizeInput:     icheck.NormalizeInput(),
+		NormalizeLowercase: icheck.NormalizeLowercase(),
+		SanitizeInput:      icheck.SanitizeInput(),
+
+		CACertFile:         icheck.CACertFile(),
+		InsecureSkipVerify: icheck.InsecureSkipVerify(),
+	}
+}
+
+// tlsSettings are the TLS settings of a class, see ent.VectorizationConfig
+type tlsSettings struct {
+	caCertFile         string
+	insecureSkipVerify bool
+}
+
+// httpClientFor returns the client reaching the embed gateway with the TLS settings
+// of config, httpClient if config does not override them
+func (v *vectorizer) httpClientFor(config ent.VectorizationConfig) (*http.Client, error) {
+	key := tlsSettings{caCertFile: config.CACertFile, insecureSkipVerify: config.InsecureSkipVerify}
+	if key == (tlsSettings{}) {
+		return v.httpClient, nil
+	}
+	v.tlsMu.Lock()
+	defer v.tlsMu.Unlock()
+	if c, ok := v.tlsClients[key]; ok {
+		return c, nil
+	}
+	var transport *http.Transport
+	if t, ok := v.httpClient.Transport.(*http.Transport); ok {
+		transport = t.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	if key.caCertFile != "" {
+		pool, err := ent.ReadCertPool(key.caCertFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	if key.insecureSkipVerify {
+		transport.TLSClientConfig.InsecureSkipVerify = true
 	}
+	c := &http.Client{Timeout: v.httpClient.Timeout, Transport: transport}
+	if v.tlsClients == nil {
+		v.tlsClients = make(map[tlsSettings]*http.Client)
+	}
+	v.tlsClients[key] = c
+	return c, nil
 }
 
 func (v *vectorizer) vectorize(ctx context.Context, input []string,
 	model, truncate, baseURL string, isSearchQuery bool, config ent.VectorizationConfig,
 ) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
-	body, err := json.Marshal(v.getEmbeddingsRequest(input, isSearchQuery, config.Dimensions))
+	if parts := batchByChars(input, v.maxBatchChars); len(parts) > 1 {
+		return v.vectorizeParts(ctx, input, parts, model, truncate, baseURL, isSearchQuery, config)
+	}
+	texts, positions := input, []int(nil)
+	if config.NormalizeInput {
+		texts, positions = normalizeInput(input, config.NormalizeLowercase)
+	}
+	if config.SanitizeInput != "" {
+		texts = sanitizeInput(texts, config.SanitizeInput)
+	}
+
+	body, err := json.Marshal(v.getEmbeddingsRequest(texts, isSearchQuery, config.Dimensions))
 	if err != nil {
 		return nil, nil, 0, errors.Wrap(err, "marshal body")
 	}
+	if v.maxPayloadBytes > 0 && len(body) > v.maxPayloadBytes {
+		if v.autoSplit && len(input) > 1 {
+			return v.vectorizeSplit(ctx, input, model, truncate, baseURL, isSearchQuery, config)
+		}
+		return nil, nil, 0, fmt.Errorf("%w: request body of %d bytes exceeds the maximum of %d bytes",
+			errPayloadTooLarge, len(body), v.maxPayloadBytes)
+	}
+
+	body, contentEncoding, err := v.compressBody(body)
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(err, "compress body")
+	}
+	client, err := v.httpClientFor(config)
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(err, "TLS settings")
+	}
 
 	url := v.getWeaviateEmbedURL(ctx, baseURL)
-	req, err := http.NewRequestWithContext(ctx, "POST", url,
-		bytes.NewReader(body))
+	// the resolved URL makes requests misrouted by base URL overrides apparent
+	v.logger.WithField("url", url).WithField("model", model).WithField("texts", len(texts)).
+		Debug("sending embeddings request")
+	var resBody embeddingsResponse
+	if v.coalesce {
+		resBody, err = v.requestEmbeddingsShared(ctx, client, url, model, body, contentEncoding, len(texts))
+	} else {
+		resBody, err = v.requestEmbeddings(ctx, client, url, model, body, contentEncoding, len(texts))
+	}
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(err, "create POST request")
+		return nil, nil, 0, err
 	}
-	apiKey, err := v.getApiKey(ctx)
+
+	if len(resBody.Embeddings) == 0 {
+		return nil, nil, 0, errors.Errorf("empty embeddings response")
+	}
+
+	embeddings := resBody.Embeddings
+	if v.validateVectors {
+		if err := checkVectorNorms(embeddings, v.minVectorNorm); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	if positions != nil {
+		if len(embeddings) != len(texts) {
+			return nil, nil, 0, errors.Errorf("expected %d embeddings, got %d", len(texts), len(embeddings))
+		}
+		embeddings = expandEmbeddings(embeddings, positions)
+	}
+
+	result := &modulecomponents.VectorizationResult[[]float32]{
+		Text:       input,
+		Dimensions: len(embeddings[0]),
+		Vector:     embeddings,
+	}
+	if usage := resBody.Metadata.Usage; usage != nil {
+		result.PromptTokens = usage.PromptTokens
+		result.TotalTokens = usage.TotalTokens
+	}
+	return result, nil, modulecomponents.GetTotalTokens(resBody.Metadata.Usage), nil
+}
+
+// requestEmbeddings sends an embeddings request for n texts, retrying malformed responses if enabled
+func (v *vectorizer) requestEmbeddings(ctx context.Context, client *http.Client,
+	url, model string, body []byte, contentEncoding string, n int,
+) (embeddingsResponse, error) {
+	var retryBackoff interval.Backoff
+	for attempt := 0; ; attempt++ {
+		resBody, err := v.sendEmbeddingsRequest(ctx, client, url, model, body, contentEncoding)
+		if err != nil {
+			return resBody, err
+		}
+		if !v.validateEmbeddings {
+			return resBody, nil
+		}
+		if err = checkEmbeddings(resBody.Embeddings, n); err == nil {
+			return resBody, nil
+		}
+		if attempt >= v.malformedRetries {
+			return resBody, fmt.Errorf("%w (%d attempts)", err, attempt+1)
+		}
+		v.logger.WithField("attempt", attempt+1).WithError(err).Debug("retrying embeddings request")
+		if v.newBackoff != nil {
+			if retryBackoff == nil {
+				retryBackoff = v.newBackoff()
+			}
+			if err := waitFor(ctx, retryBackoff.Next()); err != nil {
+				return resBody, err
+			}
+		}
+	}
+}
+
+// requestEmbeddingsShared is requestEmbeddings, except that concurrent calls for the same
+// request share a single upstream call. Callers waiting on a shared call receive its
+// outcome, including an error caused by the cancellation of the first caller's context.
+func (v *vectorizer) requestEmbeddingsShared(ctx context.Context, client *http.Client,
+	url, model string, body []byte, contentEncoding string, n int,
+) (embeddingsResponse, error) {
+	key, err := v.requestKey(ctx, client, url, model, body, contentEncoding)
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(err, "Weaviate API key")
+		return embeddingsResponse{}, err
 	}
-	clusterURL, err := v.getClusterURL(ctx)
+	leader := false
+	ch := v.inflight.DoChan(key, func() (interface{}, error) {
+		leader = true
+		return v.requestEmbeddings(ctx, client, url, model, body, contentEncoding, n)
+	})
+	if v.joined != nil {
+		v.joined()
+	}
+	r := <-ch
+	recordCacheRequest(!leader)
+	resBody, err := r.Val.(embeddingsResponse), r.Err
+	if r.Shared {
+		// each caller owns its vectors
+		embeddings := make([][]float32, len(resBody.Embeddings))
+		for i, e := range resBody.Embeddings {
+			embeddings[i] = append([]float32(nil), e...)
+		}
+		resBody.Embeddings = embeddings
+	}
+	return resBody, err
+}
+
+// recordCacheRequest counts a request served without calling the gateway as a hit,
+// and one sent to the gateway as a miss
+func recordCacheRequest(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	monitoring.GetMetrics().T2VCacheRequests.WithLabelValues(metricsLabel, result).Inc()
+}
+
+// requestKey identifies identical embeddings requests, including the credentials they
+// are sent with and the client, whose TLS settings may differ between classes
+func (v *vectorizer) requestKey(ctx context.Context, client *http.Client,
+	url, model string, body []byte, contentEncoding string,
+) (string, error) {
+	apiKey, err := v.getApiKey(ctx)
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(err, "cluster URL")
+		return "", errors.Wrap(err, "Weaviate API key")
+	}
+	h := sha256.New()
+	for _, s := range []string{
+		url, model, apiKey, contentEncoding,
+		modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Cluster-Url"),
+		modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Tenant"),
+		v.getOrganization(ctx), v.getProject(ctx), fmt.Sprintf("%p", client),
+	} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
 	}
+	h.Write(body)
+	return string(h.Sum(nil)), nil
+}
 
-	req.Header.Set("Authorization", apiKey)
+// sendEmbeddingsRequest posts body to the embed endpoint at url and decodes the response
+func (v *vectorizer) sendEmbeddingsRequest(ctx context.Context, client *http.Client,
+	url, model string, body []byte, contentEncoding string,
+) (embeddingsResponse, error) {
+	var resBody embeddingsResponse
+	req, err := http.NewRequestWithContext(ctx, "POST", url,
+		bytes.NewReader(body))
+	if err != nil {
+		return resBody, errors.Wrap(err, "create POST request")
+	}
+	if err := v.setAuthHeaders(ctx, req); err != nil {
+		return resBody, err
+	}
 	req.Header.Set("Content-Type", "application/json")
+	if v.acceptMsgpack {
+		req.Header.Set("Accept", "application/msgpack, application/json;q=0.9")
+	}
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
 	req.Header.Add("Request-Source", "unspecified:weaviate")
 	req.Header.Add("X-Model-Name", model)
-	req.Header.Add("X-Weaviate-Cluster-Url", clusterURL)
+	if tenant := modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Tenant"); tenant != "" {
+		req.Header.Add("X-Weaviate-Tenant", tenant)
+	}
+	if organization := v.getOrganization(ctx); organization != "" {
+		req.Header.Add("X-Weaviate-Organization", organization)
+	}
+	if project := v.getProject(ctx); project != "" {
+		req.Header.Add("X-Weaviate-Project", project)
+	}
 
-	res, err := v.httpClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(err, "send POST request")
+		return resBody, errors.Wrap(err, "send POST request")
 	}
-	defer res.Body.Close()
+	defer closeBody(res.Body)
+	v.recordGzipSupport(res.Header)
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(err, "read response body")
+		return resBody, errors.Wrap(err, "read response body")
 	}
 
 	if res.StatusCode > 200 {
 		errorMessage := getErrorMessage(res.StatusCode, string(bodyBytes), "Weaviate embed API error: %d %s")
-		return nil, nil, 0, errors.New(errorMessage)
+		return resBody, errors.New(errorMessage)
 	}
 
-	var resBody embeddingsResponse
-	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
-		return nil, nil, 0, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
+	if isMsgpack(res.Header) {
+		resBody, err = decodeMsgpackEmbeddings(bodyBytes)
+		if err != nil {
+			return resBody, errors.Wrap(err, "unmarshal msgpack response body")
+		}
+		return resBody, nil
+	}
+	if v.base64Encoding {
+		resBody, err = decodeBase64Embeddings(bodyBytes)
+	} else {
+		err = json.Unmarshal(bodyBytes, &resBody)
+	}
+	if err != nil {
+		return resBody, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
 	}
+	return resBody, nil
+}
 
-	if len(resBody.Embeddings) == 0 {
-		return nil, nil, 0, errors.Errorf("empty embeddings response")
+// isMsgpack returns true if the response's Content-Type is msgpack
+func isMsgpack(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && (mediaType == "application/msgpack" || mediaType == "application/x-msgpack")
+}
+
+// decodeMsgpackEmbeddings decodes a msgpack encoded response, whose keys are those of the JSON response
+func decodeMsgpackEmbeddings(bodyBytes []byte) (embeddingsResponse, error) {
+	var res embeddingsResponse
+	dec := msgpack.NewDecoder(bytes.NewReader(bodyBytes))
+	dec.SetCustomStructTag("json")
+	err := dec.Decode(&res)
+	return res, err
+}
+
+// decodeBase64Embeddings decodes a response to a request made with encoding format base64
+func decodeBase64Embeddings(bodyBytes []byte) (embeddingsResponse, error) {
+	var raw base64EmbeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &raw); err != nil {
+		return embeddingsResponse{}, err
+	}
+	res := embeddingsResponse{Embeddings: make([][]float32, len(raw.Embeddings)), Metadata: raw.Metadata}
+	for i, x := range raw.Embeddings {
+		var packed string
+		if err := json.Unmarshal(x, &packed); err != nil {
+			// not a string, fall back to an array of numbers
+			if err := json.Unmarshal(x, &res.Embeddings[i]); err != nil {
+				return embeddingsResponse{}, fmt.Errorf("embedding at index %d: %w", i, err)
+			}
+			continue
+		}
+		vector, err := decodeBase64Vector(packed)
+		if err != nil {
+			return embeddingsResponse{}, fmt.Errorf("embedding at index %d: %w", i, err)
+		}
+		res.Embeddings[i] = vector
 	}
+	return res, nil
+}
 
-	return &modulecomponents.VectorizationResult[[]float32]{
-		Text:       input,
-		Dimensions: len(resBody.Embeddings[0]),
-		Vector:     resBody.Embeddings,
-	}, nil, modulecomponents.GetTotalTokens(resBody.Metadata.Usage), nil
+// decodeBase64Vector decodes a base64 string of packed little-endian float32 values
+func decodeBase64Vector(packed string) ([]float32, error) {
+	b, err := base64.StdEncoding.DecodeString(packed)
+	if err != nil {
+		return nil, err
+	}
+	if len(b)%4 != 0 {
+		return nil, fmt.Errorf("%d bytes is not a multiple of the float32 size", len(b))
+	}
+	vector := make([]float32, len(b)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return vector, nil
+}
+
+// closeBody discards what is left unread of body, so that the underlying
+// connection can be reused, and closes it. It is safe on every return path,
+// including read errors and cancelled requests.
+func closeBody(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, maxDrainBytes))
+	body.Close()
+}
+
+// checkEmbeddings returns an error unless embeddings holds n non-empty vectors
+func checkEmbeddings(embeddings [][]float32, n int) error {
+	if len(embeddings) != n {
+		return fmt.Errorf("%w: expected %d embeddings, got %d", errMalformedEmbedding, n, len(embeddings))
+	}
+	for i, e := range embeddings {
+		if len(e) == 0 {
+			return fmt.Errorf("%w: empty embedding at index %d", errMalformedEmbedding, i)
+		}
+	}
+	return nil
+}
+
+// checkVectorNorms returns an error if a vector of embeddings holds NaN or infinite
+// values, or if its norm is zero or below minNorm, which denotes a failing model
+func checkVectorNorms(embeddings [][]float32, minNorm float64) error {
+	for i, e := range embeddings {
+		var sum float64
+		for _, x := range e {
+			if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+				return fmt.Errorf("%w: embedding at index %d holds %v", errInvalidEmbedding, i, x)
+			}
+			sum += float64(x) * float64(x)
+		}
+		if norm := math.Sqrt(sum); norm == 0 || norm < minNorm {
+			return fmt.Errorf("%w: embedding at index %d has norm %v, expected at least %v",
+				errInvalidEmbedding, i, norm, minNorm)
+		}
+	}
+	return nil
+}
+
+// vectorizeSplit vectorizes both halves of input in separate requests
+// and merges their results
+func (v *vectorizer) vectorizeSplit(ctx context.Context, input []string,
+	model, truncate, baseURL string, isSearchQuery bool, config ent.VectorizationConfig,
+) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
+	mid := len(input) / 2
+	parts := [][]string{input[:mid], input[mid:]}
+	return v.vectorizeParts(ctx, input, parts, model, truncate, baseURL, isSearchQuery, config)
+}
+
+// vectorizeParts vectorizes each of the consecutive parts of input in turn
+// and merges their results in order
+func (v *vectorizer) vectorizeParts(ctx context.Context, input []string, parts [][]string,
+	model, truncate, baseURL string, isSearchQuery bool, config ent.VectorizationConfig,
+) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
+	result := &modulecomponents.VectorizationResult[[]float32]{
+		Text:   input,
+		Vector: make([][]float32, 0, len(input)),
+	}
+	tokens := 0
+	for _, part := range parts {
+		res, _, partTokens, err := v.vectorize(ctx, part, model, truncate, baseURL, isSearchQuery, config)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if result.Dimensions == 0 {
+			result.Dimensions = res.Dimensions
+		}
+		result.Vector = append(result.Vector, res.Vector...)
+		result.PromptTokens += res.PromptTokens
+		result.TotalTokens += res.TotalTokens
+		if tokens >= 0 && partTokens >= 0 {
+			tokens += partTokens
+		} else {
+			tokens = -1
+		}
+	}
+	return result, nil, tokens, nil
+}
+
+// batchByChars splits input into consecutive batches of at most maxChars characters.
+// A text longer than maxChars forms a batch of its own. It returns a single batch
+// if maxChars <= 0.
+func batchByChars(input []string, maxChars int) [][]string {
+	if maxChars <= 0 {
+		return [][]string{input}
+	}
+	var (
+		batches [][]string
+		start   = 0
+		size    = 0
+	)
+	for i, text := range input {
+		n := utf8.RuneCountInString(text)
+		if i > start && size+n > maxChars {
+			batches = append(batches, input[start:i])
+			start, size = i, 0
+		}
+		size += n
+	}
+	return append(batches, input[start:])
+}
+
+// compressBody gzips body if compression is enabled, the body is large enough
+// and the gateway is known to accept gzip encoded requests.
+// It returns the body to send along with its content encoding.
+func (v *vectorizer) compressBody(body []byte) ([]byte, string, error) {
+	if v.gzipMinBytes <= 0 || len(body) < v.gzipMinBytes || !v.gzipSupported.Load() {
+		return body, "", nil
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return nil, "", err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "gzip", nil
+}
+
+// recordGzipSupport remembers whether the gateway advertises gzip encoded requests
+func (v *vectorizer) recordGzipSupport(header http.Header) {
+	if v.gzipMinBytes <= 0 {
+		return
+	}
+	for _, value := range header.Values("Accept-Encoding") {
+		for _, encoding := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(encoding), "gzip") {
+				v.gzipSupported.Store(true)
+				return
+			}
+		}
+	}
+}
+
+// setAuthHeaders sets the API key and cluster URL the embedding service authenticates requests with
+func (v *vectorizer) setAuthHeaders(ctx context.Context, req *http.Request) error {
+	apiKey, err := v.getApiKey(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Weaviate API key")
+	}
+	clusterURL, err := v.getClusterURL(ctx)
+	if err != nil {
+		return errors.Wrap(err, "cluster URL")
+	}
+	req.Header.Set("Authorization", apiKey)
+	req.Header.Add("X-Weaviate-Cluster-Url", clusterURL)
+	return nil
 }
 
 func (v *vectorizer) getWeaviateEmbedURL(ctx context.Context, baseURL string) string {
-	passedBaseURL := baseURL
+	return v.urlBuilder.url(v.getWeaviateBaseURL(ctx, baseURL))
+}
+
+func (v *vectorizer) getWeaviateBaseURL(ctx context.Context, baseURL string) string {
 	if headerBaseURL := modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Baseurl"); headerBaseURL != "" {
-		passedBaseURL = headerBaseURL
+		return headerBaseURL
 	}
-	return v.urlBuilder.url(passedBaseURL)
+	return baseURL
 }
 
 func (v *vectorizer) getEmbeddingsRequest(texts []string, isSearchQuery bool, dimensions *int64) embeddingsRequest {
-	return embeddingsRequest{Texts: texts, IsSearchQuery: isSearchQuery, Dimensions: dimensions}
+	req := embeddingsRequest{Texts: texts, IsSearchQuery: isSearchQuery, Dimensions: dimensions}
+	if v.base64Encoding {
+		req.EncodingFormat = "base64"
+	}
+	return req
 }
 
 func (v *vectorizer) GetApiKeyHash(ctx context.Context, config moduletools.ClassConfig) [32]byte {
@@ -181,7 +783,16 @@ func (v *vectorizer) GetApiKeyHash(ctx context.Context, config moduletools.Class
 }
 
 func (v *vectorizer) GetVectorizerRateLimit(ctx context.Context, cfg moduletools.ClassConfig) *modulecomponents.RateLimits {
-	rpm, tpm := modulecomponents.GetRateLimitFromContext(ctx, "Weaviate", DefaultRPM, DefaultTPM)
+	// the class's limits are defaults which the request headers override
+	defaultRPM, defaultTPM := DefaultRPM, DefaultTPM
+	icheck := ent.NewClassSettings(cfg)
+	if limit := icheck.RequestsPerMinute(); limit != nil {
+		defaultRPM = int(*limit)
+	}
+	if limit := icheck.TokensPerMinute(); limit != nil {
+		defaultTPM = int(*limit)
+	}
+	rpm, tpm := modulecomponents.GetRateLimitFromContext(ctx, "Weaviate", defaultRPM, defaultTPM)
 
 	execAfterRequestFunction := func(limits *modulecomponents.RateLimits, tokensUsed int, deductRequest bool) {
 		// refresh is after 60 seconds but leave a bit of room for errors. Otherwise, we only deduct the request that just happened
@@ -228,6 +839,22 @@ func (v *vectorizer) getApiKey(ctx context.Context) (string, error) {
 		"nor in environment variable under WEAVIATE_APIKEY")
 }
 
+// getOrganization returns the organization requests are attributed to, the request header taking precedence
+func (v *vectorizer) getOrganization(ctx context.Context) string {
+	if organization := modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Organization"); organization != "" {
+		return organization
+	}
+	return v.organization
+}
+
+// getProject returns the project requests are attributed to, the request header taking precedence
+func (v *vectorizer) getProject(ctx context.Context) string {
+	if project := modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Project"); project != "" {
+		return project
+	}
+	return v.project
+}
+
 func (v *vectorizer) getClusterURL(ctx context.Context) (string, error) {
 	if clusterURL := modulecomponents.GetValueFromContext(ctx, "X-Weaviate-Cluster-Url"); clusterURL != "" {
 		return clusterURL, nil
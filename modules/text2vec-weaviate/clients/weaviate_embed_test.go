@@ -12,21 +12,37 @@
 package clients
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
+	"encoding/pem"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/weaviate/weaviate/entities/interval"
 )
 
 func TestClient(t *testing.T) {
@@ -195,6 +211,33 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, "http://default-url.com/v1/embeddings/embed", buildURL)
 	})
 
+	t.Run("when the resolved URL is logged", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		logger, hook := test.NewNullLogger()
+		logger.SetLevel(logrus.DebugLevel)
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &weaviateEmbedUrlBuilder{
+				origin:   "http://default-url.com",
+				pathMask: "/v1/embeddings/embed",
+			},
+			logger: logger,
+		}
+
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		ctx = context.WithValue(ctx, "X-Weaviate-Baseurl", []string{server.URL})
+		_, _, _, err := c.Vectorize(ctx, []string{"This is my text"},
+			fakeClassConfig{classConfig: map[string]interface{}{"Model": "large", "baseURL": "http://default-url.com"}})
+		require.NoError(t, err)
+
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, logrus.DebugLevel, entry.Level)
+		assert.Equal(t, server.URL+"/v1/embeddings/embed", entry.Data["url"])
+	})
+
 	t.Run("pass rate limit headers requests", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -216,6 +259,33 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, 50, rl.RemainingRequests)
 	})
 
+	t.Run("rate limits from the class config", func(t *testing.T) {
+		c := &vectorizer{logger: nullLogger()}
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"requestsPerMinute": 100,
+			"tokensPerMinute":   2000,
+		}}
+
+		// the config is used without headers
+		rl := c.GetVectorizerRateLimit(context.Background(), cfg)
+		assert.Equal(t, 100, rl.LimitRequests)
+		assert.Equal(t, 100, rl.RemainingRequests)
+		assert.Equal(t, 2000, rl.LimitTokens)
+
+		// the headers win over the config
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Weaviate-Ratelimit-RequestPM-Embedding", []string{"50"})
+		rl = c.GetVectorizerRateLimit(ctxWithValue, cfg)
+		assert.Equal(t, 50, rl.LimitRequests)
+		assert.Equal(t, 50, rl.RemainingRequests)
+		assert.Equal(t, 2000, rl.LimitTokens)
+
+		// the defaults apply without either
+		rl = c.GetVectorizerRateLimit(context.Background(), fakeClassConfig{classConfig: map[string]interface{}{}})
+		assert.Equal(t, DefaultRPM, rl.LimitRequests)
+		assert.Equal(t, DefaultTPM, rl.LimitTokens)
+	})
+
 	t.Run("when X-Weaviate-Cluster-URL header is missing", func(t *testing.T) {
 		server := httptest.NewServer(&fakeHandler{t: t})
 		defer server.Close()
@@ -235,6 +305,717 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, "cluster URL: no cluster URL found in request header: X-Weaviate-Cluster-Url", err.Error())
 	})
 
+	t.Run("when gzip compression is enabled and the payload is large", func(t *testing.T) {
+		var encodings []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			encodings = append(encodings, r.Header.Get("Content-Encoding"))
+			var body io.Reader = r.Body
+			if r.Header.Get("Content-Encoding") == "gzip" {
+				zr, err := gzip.NewReader(r.Body)
+				require.NoError(t, err)
+				body = zr
+			}
+			var req embeddingsRequest
+			require.NoError(t, json.NewDecoder(body).Decode(&req))
+			require.Len(t, req.Texts, 1)
+
+			w.Header().Set("Accept-Encoding", "gzip")
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2, 0.3}}})
+		}))
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &weaviateEmbedUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/v1/embeddings/embed",
+			},
+			logger:       nullLogger(),
+			gzipMinBytes: 1024,
+		}
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"short text"}, cfg)
+		require.NoError(t, err)
+		// gateway has advertised support by now
+		_, _, _, err = c.Vectorize(ctxWithClusterURL, []string{"short text"}, cfg)
+		require.NoError(t, err)
+		_, _, _, err = c.Vectorize(ctxWithClusterURL, []string{strings.Repeat("large text ", 200)}, cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"", "", "gzip"}, encodings)
+	})
+
+	t.Run("when gzip compression is disabled", func(t *testing.T) {
+		var encodings []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			encodings = append(encodings, r.Header.Get("Content-Encoding"))
+			w.Header().Set("Accept-Encoding", "gzip")
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2, 0.3}}})
+		}))
+		defer server.Close()
+		c := &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &weaviateEmbedUrlBuilder{
+				origin:   server.URL,
+				pathMask: "/v1/embeddings/embed",
+			},
+			logger: nullLogger(),
+		}
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		for i := 0; i < 2; i++ {
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{strings.Repeat("large text ", 200)}, cfg)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []string{"", ""}, encodings)
+	})
+
+	t.Run("when the gateway uses a self-signed certificate", func(t *testing.T) {
+		server := httptest.NewTLSServer(&fakeHandler{t: t})
+		defer server.Close()
+		pool := x509.NewCertPool()
+		pool.AddCert(server.Certificate())
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		for name, opt := range map[string]Option{
+			"insecure skip verify": WithInsecureSkipVerify(true),
+			"custom CA pool":       WithRootCAs(pool),
+		} {
+			t.Run(name, func(t *testing.T) {
+				c := New("apiKey", time.Second, nullLogger(), opt)
+				res, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+				require.NoError(t, err)
+				assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.Vector)
+			})
+		}
+
+		t.Run("default transport", func(t *testing.T) {
+			c := New("apiKey", time.Second, nullLogger())
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "certificate")
+		})
+
+		caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caCertFile,
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+		for name, classConfig := range map[string]map[string]interface{}{
+			"class insecure skip verify": {"baseURL": server.URL, "insecureSkipVerify": true},
+			"class CA file":              {"baseURL": server.URL, "caCertFile": caCertFile},
+		} {
+			t.Run(name, func(t *testing.T) {
+				c := New("apiKey", time.Second, nullLogger())
+				res, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"},
+					fakeClassConfig{classConfig: classConfig})
+				require.NoError(t, err)
+				assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, res.Vector)
+				// the module's client is left as is for other classes
+				_, _, _, err = c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("when input normalization is enabled", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			classConfig map[string]interface{}
+			sentTexts   []string
+		}{
+			"disabled by default": {
+				classConfig: map[string]interface{}{},
+				sentTexts:   []string{"Some Text", "Some Text  ", "Other"},
+			},
+			"trims and deduplicates": {
+				classConfig: map[string]interface{}{"normalizeInput": true},
+				sentTexts:   []string{"Some Text", "Other"},
+			},
+			"lower cases": {
+				classConfig: map[string]interface{}{"normalizeInput": true, "normalizeLowercase": true},
+				sentTexts:   []string{"some text", "other"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var sent []string
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var req embeddingsRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					sent = req.Texts
+					embeddings := make([][]float32, len(req.Texts))
+					for i := range embeddings {
+						embeddings[i] = []float32{float32(i), 0.1}
+					}
+					json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: embeddings})
+				}))
+				defer server.Close()
+				c := New("apiKey", time.Second, nullLogger())
+				c.urlBuilder = &weaviateEmbedUrlBuilder{origin: server.URL, pathMask: "/v1/embeddings/embed"}
+				ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+				tt.classConfig["baseURL"] = server.URL
+				cfg := fakeClassConfig{classConfig: tt.classConfig}
+
+				input := []string{"Some Text", "Some Text  ", "Other"}
+				res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.sentTexts, sent)
+				require.Len(t, res.Vector, len(input))
+				assert.Equal(t, input, res.Text)
+				if len(sent) < len(input) {
+					assert.Equal(t, res.Vector[0], res.Vector[1])
+				}
+				assert.NotEqual(t, res.Vector[0], res.Vector[2])
+			})
+		}
+	})
+
+	t.Run("when input sanitization is enabled", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			classConfig map[string]interface{}
+			sentTexts   []string
+		}{
+			"disabled by default": {
+				classConfig: map[string]interface{}{},
+				sentTexts:   []string{"first\nline", "null\x00byte", "crlf\r\nend"},
+			},
+			"escapes": {
+				classConfig: map[string]interface{}{"sanitizeInput": "escape"},
+				sentTexts:   []string{`first\nline`, "nullbyte", `crlf\r\nend`},
+			},
+			"replaces": {
+				classConfig: map[string]interface{}{"sanitizeInput": "replace"},
+				sentTexts:   []string{"first line", "nullbyte", "crlf end"},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var sent []string
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var req embeddingsRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					sent = req.Texts
+					embeddings := make([][]float32, len(req.Texts))
+					for i := range embeddings {
+						embeddings[i] = []float32{float32(i), 0.1}
+					}
+					json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: embeddings})
+				}))
+				defer server.Close()
+				c := New("apiKey", time.Second, nullLogger())
+				ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+				tt.classConfig["baseURL"] = server.URL
+				cfg := fakeClassConfig{classConfig: tt.classConfig}
+
+				input := []string{"first\nline", "null\x00byte", "crlf\r\nend"}
+				res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.sentTexts, sent)
+				assert.Equal(t, input, res.Text)
+			})
+		}
+	})
+
+	t.Run("when the server reports usage", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			usage        *modulecomponents.Usage
+			promptTokens int
+			totalTokens  int
+		}{
+			"with usage":    {usage: &modulecomponents.Usage{PromptTokens: 7, TotalTokens: 9}, promptTokens: 7, totalTokens: 9},
+			"without usage": {},
+		} {
+			t.Run(name, func(t *testing.T) {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					json.NewEncoder(w).Encode(embeddingsResponse{
+						Embeddings: [][]float32{{0.1, 0.2, 0.3}},
+						Metadata:   metadata{Usage: tt.usage},
+					})
+				}))
+				defer server.Close()
+				c := New("apiKey", time.Second, nullLogger())
+				ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+				cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+				res, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+				require.NoError(t, err)
+				assert.Equal(t, tt.promptTokens, res.PromptTokens)
+				assert.Equal(t, tt.totalTokens, res.TotalTokens)
+			})
+		}
+	})
+
+	t.Run("when the payload exceeds the maximum size", func(t *testing.T) {
+		var requests [][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req embeddingsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			requests = append(requests, req.Texts)
+			embeddings := make([][]float32, len(req.Texts))
+			for i := range embeddings {
+				embeddings[i] = []float32{0.1, 0.2, 0.3}
+			}
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: embeddings})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		input := []string{strings.Repeat("a", 100), strings.Repeat("b", 100), strings.Repeat("c", 100)}
+
+		t.Run("auto-split disabled", func(t *testing.T) {
+			requests = nil
+			c := New("apiKey", time.Second, nullLogger(), WithMaxPayloadBytes(256))
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.ErrorIs(t, err, errPayloadTooLarge)
+			assert.Empty(t, requests)
+		})
+
+		t.Run("auto-split enabled", func(t *testing.T) {
+			requests = nil
+			c := New("apiKey", time.Second, nullLogger(), WithMaxPayloadBytes(256), WithPayloadAutoSplit(true))
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, [][]string{input[:1], input[1:]}, requests)
+			assert.Len(t, res.Vector, len(input))
+			assert.Equal(t, input, res.Text)
+		})
+
+		t.Run("single text too large", func(t *testing.T) {
+			requests = nil
+			c := New("apiKey", time.Second, nullLogger(), WithMaxPayloadBytes(64), WithPayloadAutoSplit(true))
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.ErrorIs(t, err, errPayloadTooLarge)
+			assert.Empty(t, requests)
+		})
+	})
+
+	t.Run("when texts are batched by length", func(t *testing.T) {
+		var requests [][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req embeddingsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			requests = append(requests, req.Texts)
+			embeddings := make([][]float32, len(req.Texts))
+			for i, text := range req.Texts {
+				embeddings[i] = []float32{float32(len(text)), 0.1}
+			}
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: embeddings})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		c := New("apiKey", time.Second, nullLogger(), WithMaxBatchChars(10))
+
+		input := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "aaaaaa", "bbbbbb", "cccccccccccc", "dd", "ee"}
+		res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+		require.NoError(t, err)
+		// many short texts fill a batch, long ones are sent in smaller batches
+		assert.Equal(t, [][]string{
+			input[:10],       // 10 characters
+			{"k", "aaaaaa"},  // 7 characters, adding bbbbbb would exceed 10
+			{"bbbbbb"},       // 6 characters, adding cccccccccccc would exceed 10
+			{"cccccccccccc"}, // a single text may exceed the budget
+			{"dd", "ee"},
+		}, requests)
+		assert.Equal(t, input, res.Text)
+		require.Len(t, res.Vector, len(input))
+		for i, text := range input {
+			assert.Equal(t, float32(len(text)), res.Vector[i][0])
+		}
+		assert.Equal(t, 2, res.Dimensions)
+	})
+
+	t.Run("when the gateway returns malformed embeddings", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			// a single embedding for two texts
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2, 0.3}}})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		input := []string{"first text", "second text"}
+
+		t.Run("validation disabled", func(t *testing.T) {
+			requests = 0
+			c := New("apiKey", time.Second, nullLogger())
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Len(t, res.Vector, 1)
+			assert.Equal(t, 1, requests)
+		})
+
+		t.Run("retried until retries are exhausted", func(t *testing.T) {
+			requests = 0
+			c := New("apiKey", time.Second, nullLogger(), WithMalformedResponseRetries(2))
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.ErrorIs(t, err, errMalformedEmbedding)
+			assert.ErrorContains(t, err, "expected 2 embeddings, got 1")
+			assert.Equal(t, 3, requests)
+		})
+
+		t.Run("recovers on retry", func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				embeddings := [][]float32{{0.1, 0.2}, {}}
+				if attempts > 1 {
+					embeddings[1] = []float32{0.3, 0.4}
+				}
+				json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: embeddings})
+			}))
+			defer server.Close()
+			ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+			cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+			c := New("apiKey", time.Second, nullLogger(), WithMalformedResponseRetries(2))
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, [][]float32{{0.1, 0.2}, {0.3, 0.4}}, res.Vector)
+			assert.Equal(t, 2, attempts)
+		})
+
+		t.Run("waits between retries", func(t *testing.T) {
+			requests = 0
+			created := 0
+			c := New("apiKey", time.Second, nullLogger(), WithMalformedResponseRetries(2),
+				WithRetryBackoff(func() interval.Backoff {
+					created++
+					return interval.NewConstantBackoff(20 * time.Millisecond)
+				}))
+			start := time.Now()
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.ErrorIs(t, err, errMalformedEmbedding)
+			assert.Equal(t, 3, requests)
+			assert.Equal(t, 1, created)
+			assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+		})
+
+		t.Run("stops waiting when the context is done", func(t *testing.T) {
+			requests = 0
+			c := New("apiKey", time.Second, nullLogger(), WithMalformedResponseRetries(2),
+				WithRetryBackoff(func() interval.Backoff { return interval.NewConstantBackoff(time.Hour) }))
+			ctx, cancel := context.WithTimeout(ctxWithClusterURL, 20*time.Millisecond)
+			defer cancel()
+			_, _, _, err := c.Vectorize(ctx, input, cfg)
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Equal(t, 1, requests)
+		})
+	})
+
+	t.Run("when the gateway returns invalid vectors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2}, {0, 0}}})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		input := []string{"first text", "second text"}
+
+		t.Run("validation disabled", func(t *testing.T) {
+			c := New("apiKey", time.Second, nullLogger())
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, [][]float32{{0.1, 0.2}, {0, 0}}, res.Vector)
+		})
+
+		t.Run("all-zero vector rejected", func(t *testing.T) {
+			c := New("apiKey", time.Second, nullLogger(), WithVectorValidation(0))
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.ErrorIs(t, err, errInvalidEmbedding)
+			assert.ErrorContains(t, err, "index 1")
+		})
+
+		t.Run("norm below minimum rejected", func(t *testing.T) {
+			assert.ErrorIs(t, checkVectorNorms([][]float32{{0.1, 0.2}}, 0.5), errInvalidEmbedding)
+			assert.NoError(t, checkVectorNorms([][]float32{{0.3, 0.4}}, 0.5))
+		})
+
+		t.Run("NaN and infinite values rejected", func(t *testing.T) {
+			nan, inf := float32(math.NaN()), float32(math.Inf(1))
+			assert.ErrorIs(t, checkVectorNorms([][]float32{{0.1, nan}}, 0), errInvalidEmbedding)
+			assert.ErrorIs(t, checkVectorNorms([][]float32{{inf, 0.1}}, 0), errInvalidEmbedding)
+		})
+	})
+
+	t.Run("when base64 encoding is enabled", func(t *testing.T) {
+		pack := func(vector []float32) string {
+			b := make([]byte, 4*len(vector))
+			for i, x := range vector {
+				binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(x))
+			}
+			return base64.StdEncoding.EncodeToString(b)
+		}
+		var formats []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req embeddingsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			formats = append(formats, req.EncodingFormat)
+			if req.EncodingFormat == "base64" {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"embeddings": []string{pack([]float32{0.1, -0.2, 0.3}), pack([]float32{1.5, 2, -3.25})},
+				})
+				return
+			}
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, -0.2, 0.3}, {1.5, 2, -3.25}}})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		input := []string{"first text", "second text"}
+		want := [][]float32{{0.1, -0.2, 0.3}, {1.5, 2, -3.25}}
+
+		for _, enabled := range []bool{true, false} {
+			c := New("apiKey", time.Second, nullLogger(), WithBase64Encoding(enabled))
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, want, res.Vector)
+			assert.Equal(t, 3, res.Dimensions)
+		}
+		assert.Equal(t, []string{"base64", ""}, formats)
+
+		t.Run("gateway ignoring the format", func(t *testing.T) {
+			res, err := decodeBase64Embeddings([]byte(`{"embeddings":[[0.5,1]]}`))
+			require.NoError(t, err)
+			assert.Equal(t, [][]float32{{0.5, 1}}, res.Embeddings)
+		})
+
+		t.Run("invalid packed size", func(t *testing.T) {
+			_, err := decodeBase64Embeddings([]byte(`{"embeddings":["AAA="]}`))
+			require.ErrorContains(t, err, "not a multiple")
+		})
+	})
+
+	t.Run("when msgpack responses are accepted", func(t *testing.T) {
+		var accepts []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			accepts = append(accepts, r.Header.Get("Accept"))
+			res := map[string]interface{}{
+				"embeddings": [][]float32{{0.1, -0.2, 0.3}, {1.5, 2, -3.25}},
+				"metadata":   map[string]interface{}{"model": "test", "usage": map[string]int{"prompt_tokens": 4, "total_tokens": 4}},
+			}
+			if !strings.HasPrefix(r.Header.Get("Accept"), "application/msgpack") {
+				json.NewEncoder(w).Encode(res)
+				return
+			}
+			w.Header().Set("Content-Type", "application/msgpack")
+			require.NoError(t, msgpack.NewEncoder(w).Encode(res))
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+		input := []string{"first text", "second text"}
+		want := [][]float32{{0.1, -0.2, 0.3}, {1.5, 2, -3.25}}
+
+		for _, enabled := range []bool{true, false} {
+			c := New("apiKey", time.Second, nullLogger(), WithMsgpackResponses(enabled))
+			res, _, tokens, err := c.Vectorize(ctxWithClusterURL, input, cfg)
+			require.NoError(t, err)
+			assert.Equal(t, want, res.Vector)
+			assert.Equal(t, 3, res.Dimensions)
+			assert.Equal(t, 4, res.PromptTokens)
+			assert.Equal(t, 4, tokens)
+		}
+		assert.Equal(t, []string{"application/msgpack, application/json;q=0.9", ""}, accepts)
+
+		t.Run("malformed response", func(t *testing.T) {
+			_, err := decodeMsgpackEmbeddings([]byte{0xc1})
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("when organization and project are configured", func(t *testing.T) {
+		var headers []http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = append(headers, r.Header.Clone())
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"embeddings": [][]float32{{0.1, 0.2, 0.3}},
+			})
+		}))
+		defer server.Close()
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		c := New("apiKey", time.Second, nullLogger(), WithOrganization("org-1", "proj-1"))
+		_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"text"}, cfg)
+		require.NoError(t, err)
+		// the request header takes precedence
+		ctxWithOrg := context.WithValue(ctxWithClusterURL, "X-Weaviate-Organization", []string{"org-2"})
+		_, _, _, err = c.Vectorize(ctxWithOrg, []string{"text"}, cfg)
+		require.NoError(t, err)
+		// nothing is sent when not configured
+		c = New("apiKey", time.Second, nullLogger())
+		_, _, _, err = c.Vectorize(ctxWithClusterURL, []string{"text"}, cfg)
+		require.NoError(t, err)
+
+		require.Len(t, headers, 3)
+		assert.Equal(t, "org-1", headers[0].Get("X-Weaviate-Organization"))
+		assert.Equal(t, "proj-1", headers[0].Get("X-Weaviate-Project"))
+		assert.Equal(t, "org-2", headers[1].Get("X-Weaviate-Organization"))
+		assert.Equal(t, "proj-1", headers[1].Get("X-Weaviate-Project"))
+		assert.NotContains(t, headers[2], "X-Weaviate-Organization")
+		assert.NotContains(t, headers[2], "X-Weaviate-Project")
+	})
+
+	t.Run("when requests fail or are cancelled", func(t *testing.T) {
+		var conns atomic.Int32
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req embeddingsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			if req.Texts[0] == "slow" {
+				<-r.Context().Done()
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"detail":"` + strings.Repeat("x", 4096) + `"}`))
+		}))
+		server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				conns.Add(1)
+			}
+		}
+		server.Start()
+		defer server.Close()
+		transport := &http.Transport{}
+		defer transport.CloseIdleConnections()
+		c := New("apiKey", time.Second, nullLogger())
+		c.httpClient.Transport = transport
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		t.Run("non-2xx responses reuse the connection", func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"text"}, cfg)
+				require.ErrorContains(t, err, "500")
+			}
+			assert.Equal(t, int32(1), conns.Load())
+		})
+
+		t.Run("cancelled requests do not leak", func(t *testing.T) {
+			before := runtime.NumGoroutine()
+			for i := 0; i < 20; i++ {
+				ctx, cancel := context.WithTimeout(ctxWithClusterURL, 5*time.Millisecond)
+				_, _, _, err := c.Vectorize(ctx, []string{"slow"}, cfg)
+				cancel()
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+			}
+			transport.CloseIdleConnections()
+			assert.Eventually(t, func() bool {
+				return runtime.NumGoroutine() <= before+2
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	})
+
+	t.Run("when X-Weaviate-Tenant header is passed", func(t *testing.T) {
+		var tenants []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenants = append(tenants, r.Header.Get("X-Weaviate-Tenant"))
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2, 0.3}}})
+		}))
+		defer server.Close()
+		c := New("apiKey", time.Second, nullLogger())
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		ctxWithTenant := context.WithValue(ctxWithClusterURL, "X-Weaviate-Tenant", []string{"tenant1"})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		_, _, _, err := c.Vectorize(ctxWithTenant, []string{"This is my text"}, cfg)
+		require.NoError(t, err)
+		_, _, _, err = c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"tenant1", ""}, tenants)
+	})
+
+	t.Run("when identical requests are coalesced", func(t *testing.T) {
+		var requests atomic.Int32
+		arrived, release := make(chan struct{}, 2), make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			arrived <- struct{}{}
+			<-release
+			json.NewEncoder(w).Encode(embeddingsResponse{Embeddings: [][]float32{{0.1, 0.2, 0.3}}})
+		}))
+		defer server.Close()
+		c := New("apiKey", time.Second, nullLogger(), WithRequestCoalescing(true))
+		joined := make(chan struct{}, 2)
+		c.joined = func() { joined <- struct{}{} }
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		cacheRequests := func(result string) float64 {
+			return testutil.ToFloat64(monitoring.GetMetrics().T2VCacheRequests.WithLabelValues(metricsLabel, result))
+		}
+		hits, misses := cacheRequests("hit"), cacheRequests("miss")
+
+		results := make(chan *modulecomponents.VectorizationResult[[]float32], 2)
+		vectorize := func() {
+			res, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+			assert.NoError(t, err)
+			results <- res
+		}
+		go vectorize()
+		<-arrived
+		go vectorize()
+		// both calls share the one in flight
+		<-joined
+		<-joined
+		close(release)
+
+		first, second := <-results, <-results
+		require.NotNil(t, first)
+		require.NotNil(t, second)
+		assert.Equal(t, int32(1), requests.Load())
+		assert.Equal(t, [][]float32{{0.1, 0.2, 0.3}}, first.Vector)
+		assert.Equal(t, first.Vector, second.Vector)
+		assert.NotSame(t, &first.Vector[0][0], &second.Vector[0][0])
+		assert.Equal(t, hits+1, cacheRequests("hit"))
+		assert.Equal(t, misses+1, cacheRequests("miss"))
+
+		// completed requests are not reused
+		_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), requests.Load())
+		assert.Equal(t, hits+1, cacheRequests("hit"))
+		assert.Equal(t, misses+2, cacheRequests("miss"))
+	})
+
+	t.Run("when shut down with requests in flight", func(t *testing.T) {
+		arrived, cancelled := make(chan struct{}), make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.Copy(io.Discard, r.Body) // lets the server notice the client going away
+			close(arrived)
+			<-r.Context().Done()
+			close(cancelled)
+		}))
+		defer server.Close()
+		c := New("apiKey", time.Minute, nullLogger())
+		ctxWithClusterURL := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{"baseURL": server.URL}}
+
+		errs := make(chan error, 1)
+		go func() {
+			_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+			errs <- err
+		}()
+		<-arrived
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, c.Shutdown(ctx))
+		require.ErrorIs(t, <-errs, errShutdown)
+		<-cancelled
+
+		// new calls are rejected
+		_, _, _, err := c.Vectorize(ctxWithClusterURL, []string{"This is my text"}, cfg)
+		require.ErrorIs(t, err, errShutdown)
+		_, err = c.VectorizeQuery(ctxWithClusterURL, []string{"This is my text"}, cfg)
+		require.ErrorIs(t, err, errShutdown)
+	})
+
 	t.Run("TestVectorizeRequestBodyWithCustomDimensions", func(t *testing.T) {
 		c := &vectorizer{
 			apiKey:     "apiKey",
@@ -262,6 +1043,74 @@ func TestClient(t *testing.T) {
 	})
 }
 
+func TestDescribeModel(t *testing.T) {
+	newClient := func(origin string) *vectorizer {
+		return &vectorizer{
+			apiKey:     "apiKey",
+			httpClient: &http.Client{},
+			urlBuilder: &weaviateEmbedUrlBuilder{
+				origin:   origin,
+				pathMask: "/v1/embeddings/embed",
+			},
+			logger: nullLogger(),
+		}
+	}
+
+	t.Run("with model metadata", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/embeddings/models/Snowflake/snowflake-arctic-embed-m-v1.5", r.URL.Path)
+			assert.Equal(t, "apiKey", r.Header.Get("Authorization"))
+			w.Write([]byte(`{"model":"Snowflake/snowflake-arctic-embed-m-v1.5","dimensions":768,"max_input_tokens":512,"max_batch_size":200}`))
+		}))
+		defer server.Close()
+
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		info, err := newClient(server.URL).DescribeModel(ctx, "Snowflake/snowflake-arctic-embed-m-v1.5")
+
+		require.Nil(t, err)
+		assert.Equal(t, ModelInfo{
+			Model:          "Snowflake/snowflake-arctic-embed-m-v1.5",
+			Dimensions:     768,
+			MaxInputTokens: 512,
+			MaxBatchSize:   200,
+		}, info)
+	})
+
+	t.Run("without model metadata", func(t *testing.T) {
+		embed := &fakeHandler{t: t}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			assert.Equal(t, "large", r.Header.Get("X-Model-Name"))
+			embed.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		info, err := newClient(server.URL).DescribeModel(ctx, "large")
+
+		require.Nil(t, err)
+		assert.Equal(t, ModelInfo{Model: "large", Dimensions: 3}, info)
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"detail":"invalid api key"}`))
+		}))
+		defer server.Close()
+
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		_, err := newClient(server.URL).DescribeModel(ctx, "large")
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "Weaviate embed API error: 401 invalid api key")
+	})
+}
+
 type fakeHandler struct {
 	t           *testing.T
 	serverError error
@@ -309,3 +1158,40 @@ func nullLogger() logrus.FieldLogger {
 	l, _ := test.NewNullLogger()
 	return l
 }
+
+func TestPrime(t *testing.T) {
+	t.Run("when the model responds", func(t *testing.T) {
+		var models []string
+		embed := &fakeHandler{t: t}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			models = append(models, r.Header.Get("X-Model-Name"))
+			embed.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+
+		c := New("apiKey", time.Second, nullLogger())
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		ctx = context.WithValue(ctx, "X-Weaviate-Baseurl", []string{server.URL})
+		err := c.Prime(ctx, "large")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"large"}, models)
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"detail":"model is loading"}`))
+		}))
+		defer server.Close()
+
+		c := New("apiKey", time.Second, nullLogger())
+		ctx := context.WithValue(context.Background(), "X-Weaviate-Cluster-Url", []string{server.URL})
+		ctx = context.WithValue(ctx, "X-Weaviate-Baseurl", []string{server.URL})
+		err := c.Prime(ctx, "large")
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "prime model large")
+	})
+}
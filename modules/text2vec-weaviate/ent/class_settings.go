@@ -12,7 +12,9 @@
 package ent
 
 import (
+	"crypto/x509"
 	"fmt"
+	"os"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -28,6 +30,16 @@ const (
 	DefaultPropertyIndexed       = true
 	DefaultVectorizePropertyName = false
 	LowerCaseInput               = false
+	DefaultNormalizeInput        = false
+	DefaultNormalizeLowercase    = false
+	DefaultSanitizeInput         = ""
+)
+
+const (
+	// SanitizeEscape escapes newlines as \n and \r and drops null bytes
+	SanitizeEscape = "escape"
+	// SanitizeReplace replaces newlines with spaces and drops null bytes
+	SanitizeReplace = "replace"
 )
 
 const (
@@ -62,11 +74,74 @@ func (cs *classSettings) Dimensions() *int64 {
 	return cs.BaseClassSettings.GetPropertyAsInt64("dimensions", defaultValue)
 }
 
+// NormalizeInput reports whether texts are trimmed and NFC normalized before
+// they are embedded. It is opt-in, as it changes the embedded text.
+func (cs *classSettings) NormalizeInput() bool {
+	return cs.BaseClassSettings.GetPropertyAsBool("normalizeInput", DefaultNormalizeInput)
+}
+
+// NormalizeLowercase reports whether normalized texts are also lower cased
+func (cs *classSettings) NormalizeLowercase() bool {
+	return cs.BaseClassSettings.GetPropertyAsBool("normalizeLowercase", DefaultNormalizeLowercase)
+}
+
+// SanitizeInput returns how newlines and null bytes are sanitized before texts
+// are embedded: SanitizeEscape, SanitizeReplace or empty if texts are sent as is
+func (cs *classSettings) SanitizeInput() string {
+	return cs.BaseClassSettings.GetPropertyAsString("sanitizeInput", DefaultSanitizeInput)
+}
+
+// RequestsPerMinute returns the class's default request rate limit, nil if not set.
+// The X-Weaviate-Ratelimit-RequestPM-Embedding header takes precedence over it.
+func (cs *classSettings) RequestsPerMinute() *int64 {
+	return cs.BaseClassSettings.GetPropertyAsInt64("requestsPerMinute", nil)
+}
+
+// TokensPerMinute returns the class's default token rate limit, nil if not set.
+// The X-Weaviate-Ratelimit-TokenPM-Embedding header takes precedence over it.
+func (cs *classSettings) TokensPerMinute() *int64 {
+	return cs.BaseClassSettings.GetPropertyAsInt64("tokensPerMinute", nil)
+}
+
+// CACertFile returns the PEM file of the certificate authorities trusted to reach
+// the embed gateway, e.g. an internal one using a self-signed certificate. If not
+// set, the authorities of the module are trusted (see WEAVIATE_EMBED_CA_CERT_FILE).
+func (cs *classSettings) CACertFile() string {
+	return cs.BaseClassSettings.GetPropertyAsString("caCertFile", "")
+}
+
+// InsecureSkipVerify reports whether the embed gateway's certificate is not verified.
+// It is meant for internal gateways only, as it makes connections susceptible to
+// man-in-the-middle attacks. Prefer CACertFile where possible. If not set, the
+// module's setting applies (see WEAVIATE_EMBED_INSECURE_SKIP_VERIFY).
+func (cs *classSettings) InsecureSkipVerify() bool {
+	return cs.BaseClassSettings.GetPropertyAsBool("insecureSkipVerify", false)
+}
+
 func (cs *classSettings) Validate(class *models.Class) error {
 	if err := cs.BaseClassSettings.Validate(class); err != nil {
 		return err
 	}
 
+	switch mode := cs.SanitizeInput(); mode {
+	case DefaultSanitizeInput, SanitizeEscape, SanitizeReplace:
+	default:
+		return fmt.Errorf("wrong sanitizeInput value, available values are: [%v %v]. Got %v",
+			SanitizeEscape, SanitizeReplace, mode)
+	}
+
+	if rpm := cs.RequestsPerMinute(); rpm != nil && *rpm <= 0 {
+		return fmt.Errorf("requestsPerMinute must be greater than 0. Got %v", *rpm)
+	}
+	if tpm := cs.TokensPerMinute(); tpm != nil && *tpm <= 0 {
+		return fmt.Errorf("tokensPerMinute must be greater than 0. Got %v", *tpm)
+	}
+	if file := cs.CACertFile(); file != "" {
+		if _, err := ReadCertPool(file); err != nil {
+			return fmt.Errorf("wrong caCertFile: %w", err)
+		}
+	}
+
 	if cs.Model() == SnowflakeArcticEmbedM {
 		if err := cs.ValidateSnowflakeArctic(); err != nil {
 			return err
@@ -84,6 +159,19 @@ func (cs *classSettings) ValidateSnowflakeArctic() error {
 	return nil
 }
 
+// ReadCertPool returns a pool of the certificates in the PEM file at path
+func ReadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read CA certificate file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificate found in %s", path)
+	}
+	return pool, nil
+}
+
 func PickDefaultDimensions(model string) *int64 {
 	if model == SnowflakeArcticEmbedM {
 		return &SnowflakeArcticEmbedMDefaultDimensions
@@ -59,6 +59,58 @@ func Test_classSettings_Validate(t *testing.T) {
 			},
 			wantErr: errors.New("available dimensions for model Snowflake/snowflake-arctic-embed-m-v1.5 are: [256 768]. Got 123"),
 		},
+		{
+			name: "Explicit correct sanitizeInput",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"sanitizeInput": "escape",
+				},
+			},
+		},
+		{
+			name: "Explicit wrong sanitizeInput",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"sanitizeInput": "strip",
+				},
+			},
+			wantErr: errors.New("wrong sanitizeInput value, available values are: [escape replace]. Got strip"),
+		},
+		{
+			name: "Explicit rate limits",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestsPerMinute": 100,
+					"tokensPerMinute":   100000,
+				},
+			},
+		},
+		{
+			name: "Explicit wrong requestsPerMinute",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"requestsPerMinute": 0,
+				},
+			},
+			wantErr: errors.New("requestsPerMinute must be greater than 0. Got 0"),
+		},
+		{
+			name: "Explicit insecureSkipVerify",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"insecureSkipVerify": true,
+				},
+			},
+		},
+		{
+			name: "Explicit missing caCertFile",
+			cfg: &fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"caCertFile": "/nonexistent/ca.pem",
+				},
+			},
+			wantErr: errors.New("wrong caCertFile: read CA certificate file: open /nonexistent/ca.pem: no such file or directory"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
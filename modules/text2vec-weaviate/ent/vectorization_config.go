@@ -16,4 +16,14 @@ type VectorizationConfig struct {
 	Truncate   string
 	BaseURL    string
 	Dimensions *int64
+	// NormalizeInput trims and NFC normalizes texts before they are embedded
+	NormalizeInput bool
+	// NormalizeLowercase additionally lower cases normalized texts
+	NormalizeLowercase bool
+	// SanitizeInput escapes or replaces newlines and null bytes, empty disables it
+	SanitizeInput string
+	// CACertFile and InsecureSkipVerify override the module's TLS settings
+	// of the connection to the embed gateway, if set
+	CACertFile         string
+	InsecureSkipVerify bool
 }
@@ -15,6 +15,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
@@ -25,6 +26,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/interval"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -51,6 +54,8 @@ type WeaviateEmbedModule struct {
 	nearTextTransformer          modulecapabilities.TextTransform
 	logger                       logrus.FieldLogger
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
+	// shutdown cancels the embeddings requests in flight
+	shutdown func(ctx context.Context) error
 }
 
 func New() *WeaviateEmbedModule {
@@ -103,17 +108,70 @@ func (m *WeaviateEmbedModule) initVectorizer(ctx context.Context, timeout time.D
 	logger logrus.FieldLogger,
 ) error {
 	apiKey := os.Getenv("WEAVIATE_APIKEY")
-	client := clients.New(apiKey, timeout, logger)
+	var opts []clients.Option
+	if gzipMinBytes, err := strconv.Atoi(os.Getenv("WEAVIATE_EMBED_GZIP_MIN_BYTES")); err == nil {
+		opts = append(opts, clients.WithGzipMinBytes(gzipMinBytes))
+	}
+	if maxPayloadBytes, err := strconv.Atoi(os.Getenv("WEAVIATE_EMBED_MAX_PAYLOAD_BYTES")); err == nil {
+		opts = append(opts, clients.WithMaxPayloadBytes(maxPayloadBytes),
+			clients.WithPayloadAutoSplit(entcfg.Enabled(os.Getenv("WEAVIATE_EMBED_PAYLOAD_AUTO_SPLIT"))))
+	}
+	if maxBatchChars, err := strconv.Atoi(os.Getenv("WEAVIATE_EMBED_MAX_BATCH_CHARS")); err == nil {
+		opts = append(opts, clients.WithMaxBatchChars(maxBatchChars))
+	}
+	if minNorm, err := strconv.ParseFloat(os.Getenv("WEAVIATE_EMBED_MIN_VECTOR_NORM"), 64); err == nil {
+		opts = append(opts, clients.WithVectorValidation(minNorm))
+	}
+	if os.Getenv("WEAVIATE_EMBED_ENCODING_FORMAT") == "base64" {
+		opts = append(opts, clients.WithBase64Encoding(true))
+	}
+	if entcfg.Enabled(os.Getenv("WEAVIATE_EMBED_ACCEPT_MSGPACK")) {
+		opts = append(opts, clients.WithMsgpackResponses(true))
+	}
+	if initial, err := time.ParseDuration(os.Getenv("WEAVIATE_EMBED_RETRY_BACKOFF")); err == nil && initial > 0 {
+		opts = append(opts, clients.WithRetryBackoff(func() interval.Backoff {
+			return interval.NewJitteredBackoff(interval.NewExponentialBackoff(initial, 30*initial, 2), 0.2)
+		}))
+	}
+	if entcfg.Enabled(os.Getenv("WEAVIATE_EMBED_COALESCE_REQUESTS")) {
+		opts = append(opts, clients.WithRequestCoalescing(true))
+	}
+	if organization, project := os.Getenv("WEAVIATE_EMBED_ORGANIZATION"), os.Getenv("WEAVIATE_EMBED_PROJECT"); organization != "" || project != "" {
+		opts = append(opts, clients.WithOrganization(organization, project))
+	}
+	// defaults for classes which do not set caCertFile or insecureSkipVerify
+	if caCertFile := os.Getenv("WEAVIATE_EMBED_CA_CERT_FILE"); caCertFile != "" {
+		pool, err := ent.ReadCertPool(caCertFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clients.WithRootCAs(pool))
+	}
+	// only meant for internal embed gateways using self-signed certificates
+	if entcfg.Enabled(os.Getenv("WEAVIATE_EMBED_INSECURE_SKIP_VERIFY")) {
+		opts = append(opts, clients.WithInsecureSkipVerify(true))
+	}
+	client := clients.New(apiKey, timeout, logger, opts...)
 
 	m.vectorizer = text2vecbase.New(client,
 		batch.NewBatchVectorizer(client, 50*time.Second, batchSettings, logger, m.Name()),
 		batch.ReturnBatchTokenizer(batchSettings.TokenMultiplier, m.Name(), ent.LowerCaseInput),
 	)
 	m.metaProvider = client
+	m.shutdown = client.Shutdown
 
 	return nil
 }
 
+// Shutdown rejects new vectorizations and cancels the ones in flight,
+// waiting for them to return until ctx is done
+func (m *WeaviateEmbedModule) Shutdown(ctx context.Context) error {
+	if m.shutdown == nil {
+		return nil
+	}
+	return m.shutdown(ctx)
+}
+
 func (m *WeaviateEmbedModule) initAdditionalPropertiesProvider() error {
 	m.additionalPropertiesProvider = additional.NewText2VecProvider()
 	return nil
@@ -161,4 +219,5 @@ var (
 	_ = modulecapabilities.Searcher[[]float32](New())
 	_ = modulecapabilities.GraphQLArguments(New())
 	_ = modulecapabilities.InputVectorizer[[]float32](New())
+	_ = modulecapabilities.ModuleWithShutdown(New())
 )
@@ -89,6 +89,11 @@ func FromEnv(config *Config) error {
 		config.ForceFullReplicasSearch = true
 	}
 
+	// Answer reads at consistency level ONE from the local replica, if any
+	if entcfg.Enabled(os.Getenv("REPLICATION_LOCAL_READS")) {
+		config.ReplicationLocalReads = true
+	}
+
 	// Recount all property lengths at startup to support accurate BM25 scoring
 	if entcfg.Enabled(os.Getenv("RECOUNT_PROPERTIES_AT_STARTUP")) {
 		config.RecountPropertiesAtStartup = true
@@ -83,6 +83,9 @@ type VectorizationResult[T dto.Embedding] struct {
 	Dimensions int
 	Vector     []T
 	Errors     []error
+	// token usage reported by the provider, zero if not reported
+	PromptTokens int
+	TotalTokens  int
 }
 
 type VectorizationCLIPResult[T dto.Embedding] struct {
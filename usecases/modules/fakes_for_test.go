@@ -194,6 +194,21 @@ func (m dummyNonVectorizerModule) Type() modulecapabilities.ModuleType {
 	return non
 }
 
+type dummyShutdownModule struct {
+	dummyNonVectorizerModule
+	err  error
+	shut *bool
+}
+
+func newDummyShutdownModule(name string, err error) dummyShutdownModule {
+	return dummyShutdownModule{newDummyNonVectorizerModule(name), err, new(bool)}
+}
+
+func (m dummyShutdownModule) Shutdown(ctx context.Context) error {
+	*m.shut = true
+	return m.err
+}
+
 type fakeSchemaGetter struct{ schema schema.Schema }
 
 func (f *fakeSchemaGetter) ReadOnlyClass(name string) *models.Class {
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"strings"
 	"sync"
 
 	"github.com/weaviate/weaviate/entities/dto"
@@ -150,6 +151,23 @@ func (p *Provider) Init(ctx context.Context,
 	return nil
 }
 
+// Shutdown shuts down all modules implementing modulecapabilities.ModuleWithShutdown.
+// Every module is shut down, even if shutting down another one failed.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []string
+	for _, mod := range p.GetAll() {
+		if modShutdown, ok := mod.(modulecapabilities.ModuleWithShutdown); ok {
+			if err := modShutdown.Shutdown(ctx); err != nil {
+				errs = append(errs, fmt.Sprintf("%q: %v", mod.Name(), err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("shutdown modules: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
 func (p *Provider) validate() error {
 	searchers := map[string][]string{}
 	additionalGraphQLProps := map[string][]string{}
@@ -551,3 +551,18 @@ func (m *dummyBackupModuleWithAltNames) PutFile(ctx context.Context, backupID, k
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID, overrideBucket, overridePath string) error {
 	return nil
 }
+
+func TestModulesProviderShutdown(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	modulesProvider := NewProvider(logger)
+	mod1 := newDummyShutdownModule("mod1", fmt.Errorf("still busy"))
+	mod2 := newDummyShutdownModule("mod2", nil)
+	modulesProvider.Register(mod1)
+	modulesProvider.Register(newDummyNonVectorizerModule("mod3"))
+	modulesProvider.Register(mod2)
+
+	err := modulesProvider.Shutdown(context.Background())
+	assert.ErrorContains(t, err, `"mod1": still busy`)
+	assert.True(t, *mod1.shut)
+	assert.True(t, *mod2.shut)
+}
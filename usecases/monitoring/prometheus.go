@@ -137,6 +137,7 @@ type PrometheusMetrics struct {
 	T2VTokensInRequest    *prometheus.HistogramVec
 	T2VRateLimitStats     *prometheus.GaugeVec
 	T2VRequestsPerBatch   *prometheus.HistogramVec
+	T2VCacheRequests      *prometheus.CounterVec
 }
 
 func NewTenantOffloadMetrics(cfg Config, reg prometheus.Registerer) *TenantOffloadMetrics {
@@ -684,6 +685,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help:    "Number of requests required to process an entire (user) batch",
 			Buckets: []float64{1, 2, 5, 10, 100, 1000},
 		}, []string{"vectorizer"}),
+		T2VCacheRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "t2v_cache_requests_total",
+			Help: "Number of vectorizer requests served from a cache or a shared in-flight request (hit) or sent upstream (miss)",
+		}, []string{"vectorizer", "result"}),
 	}
 }
 
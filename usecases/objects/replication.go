@@ -115,6 +115,8 @@ type Replica struct {
 	Deleted                 bool            `json:"deleted"`
 	Object                  *storobj.Object `json:"object,omitempty"`
 	LastUpdateTimeUnixMilli int64           `json:"lastUpdateTimeUnixMilli"`
+	// Generation of the shard on the sender, 0 if unknown
+	Generation uint64 `json:"generation,omitempty"`
 }
 
 // robjectMarshaler is a helper for the methods implementing encoding.BinaryMarshaler
@@ -127,6 +129,7 @@ type robjectMarshaler struct {
 	Deleted                 bool
 	LastUpdateTimeUnixMilli int64
 	Object                  []byte
+	Generation              uint64 `json:",omitempty"`
 }
 
 func (r *Replica) MarshalBinary() ([]byte, error) {
@@ -134,6 +137,7 @@ func (r *Replica) MarshalBinary() ([]byte, error) {
 		ID:                      r.ID,
 		Deleted:                 r.Deleted,
 		LastUpdateTimeUnixMilli: r.LastUpdateTimeUnixMilli,
+		Generation:              r.Generation,
 	}
 	if r.Object != nil {
 		obj, err := r.Object.MarshalBinary()
@@ -156,6 +160,7 @@ func (r *Replica) UnmarshalBinary(data []byte) error {
 	r.ID = b.ID
 	r.Deleted = b.Deleted
 	r.LastUpdateTimeUnixMilli = b.LastUpdateTimeUnixMilli
+	r.Generation = b.Generation
 
 	if b.Object != nil {
 		var obj storobj.Object
@@ -179,6 +184,7 @@ func (ro Replicas) MarshalBinary() ([]byte, error) {
 			ID:                      obj.ID,
 			Deleted:                 obj.Deleted,
 			LastUpdateTimeUnixMilli: obj.LastUpdateTimeUnixMilli,
+			Generation:              obj.Generation,
 		}
 		if obj.Object != nil {
 			b, err := obj.Object.MarshalBinary()
@@ -207,6 +213,7 @@ func (ro *Replicas) UnmarshalBinary(data []byte) error {
 			ID:                      m.ID,
 			Deleted:                 m.Deleted,
 			LastUpdateTimeUnixMilli: m.LastUpdateTimeUnixMilli,
+			Generation:              m.Generation,
 		}
 		if m.Object != nil {
 			var obj storobj.Object
@@ -176,8 +176,9 @@ func Test_Replica_MarshalBinary(t *testing.T) {
 
 			t.Run("when object is present", func(t *testing.T) {
 				expected := Replica{
-					Object: &obj,
-					ID:     obj.ID(),
+					Object:     &obj,
+					ID:         obj.ID(),
+					Generation: 3,
 				}
 
 				b, err := expected.MarshalBinary()
@@ -190,6 +191,7 @@ func Test_Replica_MarshalBinary(t *testing.T) {
 				assert.EqualValues(t, expected.Object, received.Object)
 				assert.EqualValues(t, expected.ID, received.ID)
 				assert.EqualValues(t, expected.Deleted, received.Deleted)
+				assert.EqualValues(t, expected.Generation, received.Generation)
 			})
 
 			t.Run("when object is nil", func(t *testing.T) {
@@ -311,8 +313,9 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 						ID:     obj1.ID(),
 					},
 					{
-						Object: &obj2,
-						ID:     obj2.ID(),
+						Object:     &obj2,
+						ID:         obj2.ID(),
+						Generation: 3,
 					},
 				}
 
@@ -330,6 +333,7 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 				assert.EqualValues(t, expected[1].Object, received[1].Object)
 				assert.EqualValues(t, expected[1].ID, received[1].ID)
 				assert.EqualValues(t, expected[1].Deleted, received[1].Deleted)
+				assert.EqualValues(t, expected[1].Generation, received[1].Generation)
 			})
 
 			t.Run("when there is a nil object", func(t *testing.T) {
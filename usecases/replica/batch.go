@@ -78,6 +78,41 @@ type shardPart struct {
 	Index []int // index for data
 }
 
+// dedup removes positions holding an object whose id occurs earlier in the part,
+// so that each id is read only once. It returns a map from each removed position
+// to the position kept in its place. See expand.
+func (b *shardPart) dedup() map[int]int {
+	var (
+		seen  = make(map[strfmt.UUID]int, len(b.Index))
+		index = make([]int, 0, len(b.Index))
+		dups  map[int]int
+	)
+	for _, idx := range b.Index {
+		id := b.Data[idx].ID()
+		if kept, ok := seen[id]; ok {
+			if dups == nil {
+				dups = make(map[int]int)
+			}
+			dups[idx] = kept
+			continue
+		}
+		seen[id] = idx
+		index = append(index, idx)
+	}
+	if dups != nil {
+		b.Index = index
+	}
+	return dups
+}
+
+// expand restores the positions removed by dedup with the objects
+// resolved for the positions kept in their place
+func (b *shardPart) expand(dups map[int]int) {
+	for idx, kept := range dups {
+		b.Data[idx] = b.Data[kept]
+	}
+}
+
 func (b *shardPart) ObjectIDs() []strfmt.UUID {
 	xs := make([]strfmt.UUID, len(b.Index))
 	for i, idx := range b.Index {
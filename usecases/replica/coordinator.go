@@ -14,12 +14,16 @@ package replica
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/weaviate/weaviate/cluster/utils"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/interval"
 
 	"github.com/sirupsen/logrus"
 )
@@ -51,6 +55,30 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+		// minAcks raises the number of replies Pull requires above the consistency level
+		minAcks int
+		// maxFailures, if not negative, caps the number of replicas which may fail
+		// by raising the number of replies Pull requires accordingly
+		maxFailures int
+		// retryBudget, if not nil, is the number of retries Pull may still issue.
+		// It is shared by the coordinators of a single read
+		retryBudget *atomic.Int64
+		// maxFanOut, if positive, caps the number of replicas Pull may query,
+		// fallbacks included, though never below the number of required replies
+		maxFanOut int
+		// breaker, if not nil, moves degraded replicas to the end of the hosts
+		// unless a direct candidate is given
+		breaker *stalenessBreaker
+		// health, if not nil, moves replicas of unhealthy nodes to the end of the hosts
+		// unless a direct candidate is given
+		health NodeHealth
+		// hedgeDelay, if positive, makes Pull at level one query the next replica
+		// whenever the pending ones have not replied after this delay.
+		// Only the full reads of GetOne set it, see Finder.GetOne
+		hedgeDelay time.Duration
+		// newBackoff, if not nil, replaces the default exponential backoff
+		// between Pull retries of a host
+		newBackoff func() interval.Backoff
 	}
 )
 
@@ -82,9 +110,24 @@ func newReadCoordinator[T any](f *Finder, shard string,
 		pullBackOffPreInitialInterval: pullBackOffInitivalInterval / 2,
 		pullBackOffMaxElapsedTime:     pullBackOffMaxElapsedTime,
 		deletionStrategy:              deletionStrategy,
+		maxFailures:                   -1,
+		breaker:                       f.breaker,
+		health:                        f.health,
+		newBackoff:                    f.newBackoff,
 	}
 }
 
+// withReadOptions applies the per-read options o to a read coordinator
+func (c *coordinator[T]) withReadOptions(o readOptions) {
+	c.minAcks, c.maxFailures, c.retryBudget = o.minAcks, o.maxFailures, o.retryBudget
+	c.maxFanOut = o.maxFanOut
+}
+
+// spendRetry consumes one retry from the budget and reports whether it was available
+func (c *coordinator[T]) spendRetry() bool {
+	return c.retryBudget == nil || c.retryBudget.Add(-1) >= 0
+}
+
 // broadcast sends write request to all replicas (first phase of a two-phase commit)
 func (c *coordinator[T]) broadcast(ctx context.Context,
 	replicas []string,
@@ -119,7 +162,7 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 		actives := make([]string, 0, level) // cache for active replicas
 		for r := range prepare() {
 			if r.Err != nil { // connection error
-				c.log.WithField("op", "broadcast").Error(r.Err)
+				labeled(ctx, c.log).WithField("op", "broadcast").Error(r.Err)
 				continue
 			}
 
@@ -137,7 +180,7 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 		}
 		if level > 0 { // abort: nothing has been sent to the caller
 			fs := logrus.Fields{"op": "broadcast", "active": len(actives), "total": len(replicas)}
-			c.log.WithFields(fs).Error("abort")
+			labeled(ctx, c.log).WithFields(fs).Error("abort")
 			for _, node := range replicas {
 				c.Abort(ctx, node, c.Class, c.Shard, c.TxID)
 			}
@@ -187,7 +230,7 @@ func (c *coordinator[T]) Push(ctx context.Context,
 	level := state.Level
 	//nolint:govet // we expressely don't want to cancel that context as the timeout will take care of it
 	ctxWithTimeout, _ := context.WithTimeout(context.Background(), 20*time.Second)
-	c.log.WithFields(logrus.Fields{
+	labeled(ctx, c.log).WithFields(logrus.Fields{
 		"action":   "coordinator_push",
 		"duration": 20 * time.Second,
 		"level":    level,
@@ -216,7 +259,35 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 	if err != nil {
 		return nil, state, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
+	minAcks := c.minAcks
+	if c.maxFailures >= 0 && state.Len()-c.maxFailures > minAcks {
+		minAcks = state.Len() - c.maxFailures
+	}
+	if minAcks > state.Level {
+		if n := len(state.Hosts); minAcks > n {
+			return nil, state, fmt.Errorf("minimum acknowledgments (%d) > available replicas(%d) : class %q shard %q",
+				minAcks, n, c.Class, c.Shard)
+		}
+		state.Level = minAcks
+	}
+	fanOut := max(c.maxFanOut, state.Level)
+	if c.maxFanOut > 0 && len(state.Hosts) > fanOut {
+		// sample the peers of the first host, which may be the direct candidate
+		hosts := slices.Clone(state.Hosts)
+		rand.Shuffle(len(hosts)-1, func(i, j int) { hosts[i+1], hosts[j+1] = hosts[j+1], hosts[i+1] })
+		state.Hosts = hosts
+	}
+	if directCandidate == "" {
+		state.Hosts = c.breaker.healthyFirst(state.Hosts)
+		state.Hosts = healthyFirst(c.health, state)
+	}
+	if c.maxFanOut > 0 && len(state.Hosts) > fanOut {
+		state.Hosts = state.Hosts[:fanOut]
+	}
 	level := state.Level
+	if level == 1 && c.hedgeDelay > 0 && len(state.Hosts) > 1 {
+		return c.pullHedged(ctx, op, state, timeout), state, nil
+	}
 	replyCh := make(chan _Result[T], level)
 	hosts := state.Hosts
 	f := func() {
@@ -226,7 +297,7 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 		for i := level; i < len(hosts); i++ {
 			hostRetryQueue <- hostRetry{
 				hosts[i],
-				backoff.WithContext(utils.NewExponentialBackoff(c.pullBackOffPreInitialInterval, c.pullBackOffMaxElapsedTime), ctx),
+				c.pullBackOff(ctx),
 			}
 		}
 
@@ -255,12 +326,16 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 				// this host failed op on the first try, put it on the retry queue
 				hostRetryQueue <- hostRetry{
 					hosts[hostIndex],
-					backoff.WithContext(utils.NewExponentialBackoff(c.pullBackOffPreInitialInterval, c.pullBackOffMaxElapsedTime), ctx),
+					c.pullBackOff(ctx),
 				}
 
 				// let's fallback to the backups in the retry queue
 				for hr := range hostRetryQueue {
-					resp, err := op(workerCtx, hr.host, isFullReadWorker)
+					if !c.spendRetry() {
+						replyCh <- _Result[T]{resp, fmt.Errorf("%w: %w", errRetryBudget, err)}
+						return
+					}
+					resp, err = op(workerCtx, hr.host, isFullReadWorker)
 					if err == nil {
 						replyCh <- _Result[T]{resp, err}
 						return
@@ -303,3 +378,132 @@ type hostRetry struct {
 	host           string
 	currentBackOff backoff.BackOff
 }
+
+// pullBackOff returns the backoff used between retries of a single host.
+// Retries stop once pullBackOffMaxElapsedTime has passed or ctx is done.
+func (c *coordinator[T]) pullBackOff(ctx context.Context) backoff.BackOff {
+	if c.newBackoff == nil {
+		return backoff.WithContext(utils.NewExponentialBackoff(c.pullBackOffPreInitialInterval, c.pullBackOffMaxElapsedTime), ctx)
+	}
+	b := &intervalBackOff{b: c.newBackoff(), maxElapsed: c.pullBackOffMaxElapsedTime}
+	b.Reset()
+	return backoff.WithContext(b, ctx)
+}
+
+// intervalBackOff adapts an interval.Backoff to the backoff.BackOff interface
+type intervalBackOff struct {
+	b          interval.Backoff
+	maxElapsed time.Duration
+	start      time.Time
+}
+
+func (b *intervalBackOff) NextBackOff() time.Duration {
+	d := b.b.Next()
+	if b.maxElapsed > 0 && time.Since(b.start)+d > b.maxElapsed {
+		return backoff.Stop
+	}
+	return d
+}
+
+func (b *intervalBackOff) Reset() {
+	b.b.Reset()
+	b.start = time.Now()
+}
+
+// pullHedged sends a fullread op to the first replica and, each time
+// c.hedgeDelay elapses or a replica fails, to the next one. Like the workers of
+// Pull, every request after the first is charged against the retry budget, and
+// replicas which failed are retried after their backoff once all have been tried.
+// The first successful reply is returned; pending requests are cancelled.
+func (c *coordinator[T]) pullHedged(ctx context.Context,
+	op readOp[T], state rState,
+	timeout time.Duration,
+) <-chan _Result[T] {
+	replyCh := make(chan _Result[T], 1)
+	f := func() {
+		defer close(replyCh)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		type reply struct {
+			host string
+			_Result[T]
+		}
+		var (
+			hosts    = state.Hosts
+			results  = make(chan reply, len(hosts))
+			retries  = make(chan string, len(hosts)) // failed hosts whose backoff has elapsed
+			backoffs = make(map[string]backoff.BackOff, len(hosts))
+			next     = 1 // index of the next host never queried
+			pending  = 0 // requests and scheduled retries not completed
+			last     _Result[T]
+		)
+		send := func(host string) {
+			pending++
+			enterrors.GoWrapper(func() {
+				// level one: the only reply must hold the content
+				resp, err := op(ctx, host, true)
+				results <- reply{host, _Result[T]{resp, err}}
+			}, c.log)
+		}
+		// hedge sends op to host unless the retry budget is spent
+		hedge := func(host string) bool {
+			if !c.spendRetry() {
+				return false
+			}
+			send(host)
+			return true
+		}
+		// retry hedges host after a failure, or schedules it once its backoff
+		// has elapsed if all hosts have already been queried
+		retry := func(host string) {
+			if next < len(hosts) {
+				host = hosts[next]
+				next++
+			} else {
+				b, ok := backoffs[host]
+				if !ok {
+					b = c.pullBackOff(ctx)
+					backoffs[host] = b
+				}
+				if d := b.NextBackOff(); d != backoff.Stop {
+					pending++
+					time.AfterFunc(d, func() { retries <- host })
+				}
+				return
+			}
+			if !hedge(host) {
+				last.Err = fmt.Errorf("%w: %w", errRetryBudget, last.Err)
+			}
+		}
+		send(hosts[0])
+
+		timer := time.NewTimer(c.hedgeDelay)
+		defer timer.Stop()
+		for pending > 0 {
+			select {
+			case r := <-results:
+				pending--
+				if r.Err == nil {
+					replyCh <- r._Result
+					return
+				}
+				last = r._Result
+				retry(r.host)
+			case host := <-retries:
+				pending--
+				if ctx.Err() == nil && !hedge(host) {
+					last.Err = fmt.Errorf("%w: %w", errRetryBudget, last.Err)
+				}
+			case <-timer.C:
+				if next < len(hosts) && hedge(hosts[next]) {
+					next++
+					timer.Reset(c.hedgeDelay)
+				}
+			}
+		}
+		replyCh <- last
+	}
+	enterrors.GoWrapper(f, c.log)
+	return replyCh
+}
@@ -15,11 +15,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/interval"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -37,6 +44,19 @@ var (
 	errReplicas = errors.New("cannot reach enough replicas")
 	errRepair   = errors.New("read repair error")
 	errRead     = errors.New("read error")
+	// errUnknownShard class or shard cannot be resolved (configuration problem)
+	errUnknownShard = fmt.Errorf("%w: unknown class or shard", errReplicas)
+	// errNoLiveReplicas shard is known but none of its replicas is available
+	errNoLiveReplicas = fmt.Errorf("%w: shard has no live replicas", errReplicas)
+	// errGeneration replica holds another generation of the shard than expected
+	errGeneration = errors.New("replica generation mismatch")
+	// errRetryBudget the retries allowed for a read have been used up
+	errRetryBudget = errors.New("retry budget exhausted")
+
+	// errTopology replicas disagree about which nodes replicate a shard
+	errTopology = errors.New("replica topology mismatch")
+	// errEmptyReply a replica replied to a batch read with no entry at all
+	errEmptyReply = errors.New("empty batch reply")
 )
 
 type (
@@ -62,6 +82,25 @@ type Finder struct {
 	// control the op backoffs in the coordinator's Pull
 	coordinatorPullBackoffInitialInterval time.Duration
 	coordinatorPullBackoffMaxElapsedTime  time.Duration
+	// fraction of reads at level ONE verified against another replica
+	oneVerificationRate float64
+	// delay after which reads at level ONE are also sent to the next replica
+	hedgeDelay time.Duration
+	// newBackoff, if not nil, creates the backoff used between retries of a replica
+	newBackoff func() interval.Backoff
+	// local reads replicas held by this node without a network round trip
+	local LocalReader
+	// affinity maps shards to the node which last served a direct read, nil disables it
+	affinity *sync.Map
+	// health steers reads away from unhealthy nodes, it may be nil
+	health NodeHealth
+
+	// background work started by reads (verification, repair)
+	bgMu     sync.Mutex
+	bgClosed bool
+	bgWg     sync.WaitGroup
+	bgCtx    context.Context
+	bgCancel context.CancelFunc
 }
 
 // NewFinder constructs a new finder instance
@@ -72,9 +111,10 @@ func NewFinder(className string,
 	coordinatorPullBackoffInitialInterval time.Duration,
 	coordinatorPullBackoffMaxElapsedTime time.Duration,
 	deletionStrategy string,
+	opts ...FinderOption,
 ) *Finder {
-	cl := finderClient{client}
-	return &Finder{
+	cl := finderClient{cl: client}
+	f := &Finder{
 		resolver: resolver,
 		finderStream: finderStream{
 			repairer: repairer{
@@ -88,6 +128,55 @@ func NewFinder(className string,
 		coordinatorPullBackoffInitialInterval: coordinatorPullBackoffInitialInterval,
 		coordinatorPullBackoffMaxElapsedTime:  coordinatorPullBackoffMaxElapsedTime,
 	}
+	f.bgCtx, f.bgCancel = context.WithCancel(context.Background())
+	f.background = f.goBackground
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Close stops the finder from starting new background work and waits for
+// in-flight background work to finish. If ctx expires first, in-flight work
+// is cancelled and ctx's error is returned once it has stopped.
+func (f *Finder) Close(ctx context.Context) error {
+	f.bgMu.Lock()
+	f.bgClosed = true
+	f.bgMu.Unlock()
+
+	done := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		f.bgWg.Wait()
+		close(done)
+	}, f.logger)
+
+	select {
+	case <-done:
+		f.bgCancel()
+		return nil
+	case <-ctx.Done():
+		f.bgCancel()
+		<-done
+		return fmt.Errorf("close finder: %w", ctx.Err())
+	}
+}
+
+// goBackground runs fn in the background with a context which expires after
+// timeout or when the finder is closed. fn is not run if the finder is closed.
+func (f *Finder) goBackground(timeout time.Duration, fn func(ctx context.Context)) {
+	f.bgMu.Lock()
+	defer f.bgMu.Unlock()
+	if f.bgClosed {
+		return
+	}
+	f.bgWg.Add(1)
+	g := func() {
+		defer f.bgWg.Done()
+		ctx, cancel := context.WithTimeout(f.bgCtx, timeout)
+		defer cancel()
+		fn(ctx)
+	}
+	enterrors.GoWrapper(g, f.logger)
 }
 
 // GetOne gets object which satisfies the giving consistency
@@ -96,22 +185,112 @@ func (f *Finder) GetOne(ctx context.Context,
 	id strfmt.UUID,
 	props search.SelectProperties,
 	adds additional.Properties,
-) (*storobj.Object, error) {
+	opts ...ReadOption,
+) (_ *storobj.Object, err error) {
+	o := newReadOptions(opts)
+	if o.degradeToQuorum && l == All {
+		return f.getOneDegraded(ctx, shard, id, props, adds, opts)
+	}
+	ctx, countDone := o.countRPCs(ctx)
+	defer countDone()
+	f.recent.touch(id)
+	if o.metadataOnly {
+		props, adds = nil, metadataOnly
+	}
+	o.props, o.vector = props, adds.Vector
+	if o.localVersion > 0 {
+		o.localHost, _ = f.resolver.NodeHostname(f.resolver.NodeName)
+	}
+	if o.acks != nil {
+		defer func() { *o.acks = o.ackSet.sorted() }()
+	}
+	if o.provenance != nil {
+		defer func() {
+			if err != nil {
+				return
+			}
+			level := l // ONE if served before a background repair
+			if o.soleSurvivor && o.soleRead.Load() {
+				level = One
+			}
+			*o.provenance = Provenance{Level: level, Nodes: o.ackSet.sorted()}
+		}()
+	}
+	if o.staleness != nil {
+		*o.staleness = map[strfmt.UUID]time.Duration{}
+	}
+	if o.backgroundRepair && l != One {
+		defer f.repairInBackground(l, shard, id, props, adds, o)
+		l = One
+	}
+	direct := f.affineNode(shard)
+	vectorNode := ""
+	if adds.Vector || len(adds.Vectors) > 0 {
+		vectorNode = f.vectorNode(shard)
+	}
+	if vectorNode != "" {
+		direct = vectorNode
+	}
+	if l == One && o.minAcks <= 1 && o.maxFailures < 0 && o.maxAge <= 0 && o.trace == nil && o.digests == nil &&
+		!o.metadataOnly && wholeObject(props, adds) && f.isLocalReplica(shard) && f.healthy(f.resolver.NodeName) {
+		r, err := f.local.FetchObject(ctx, shard, id)
+		if err == nil {
+			err = o.checkGenerationOf(f.resolver.NodeName, r.Generation)
+		}
+		if err == nil && r.Deleted {
+			o.ackSet.add(f.resolver.NodeName)
+			return nil, nil
+		}
+		if err == nil && r.Object != nil {
+			o.ackSet.add(f.resolver.NodeName)
+			f.auditRead(ctx, l, shard, f.resolver.NodeName, r.Object)
+			if o.staleness != nil {
+				*o.staleness = o.stalenessOf(r.Object)
+			}
+			return r.Object, nil
+		}
+		// not available locally, fall back to remote replicas
+	}
 	c := newReadCoordinator[findOneReply](f, shard,
 		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	c.withReadOptions(o)
+	c.hedgeDelay = f.hedgeDelay // at level one, the direct read is the only read
+	var (
+		mu       sync.Mutex
+		servedBy string // host which served the full read
+	)
 	op := func(ctx context.Context, host string, fullRead bool) (findOneReply, error) {
 		if fullRead {
 			r, err := f.client.FullRead(ctx, host, f.class, shard, id, props, adds, 0)
+			if err == nil {
+				err = o.checkGenerationOf(host, r.Generation)
+			}
+			if err == nil {
+				o.digestSet.add(host, RepairResponse{ID: id.String(), UpdateTime: r.UpdateTime(), Deleted: r.Deleted})
+				mu.Lock()
+				servedBy = host
+				mu.Unlock()
+			} else if node := f.affineNode(shard); node != "" {
+				if addr, _ := f.resolver.NodeHostname(node); addr == host {
+					f.setAffinity(shard, "") // fall back to any replica
+				}
+			}
 
 			return findOneReply{host, 0, r, r.UpdateTime(), false}, err
 		} else {
 			xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id}, 0)
+			if err == nil {
+				err = o.checkGeneration(host, xs)
+			}
 
 			var x RepairResponse
 
 			if len(xs) == 1 {
 				x = xs[0]
 			}
+			if err == nil {
+				o.digestSet.add(host, x)
+			}
 
 			r := objects.Replica{
 				ID:                      id,
@@ -122,21 +301,236 @@ func (f *Finder) GetOne(ctx context.Context,
 			return findOneReply{host, x.Version, r, x.UpdateTime, true}, err
 		}
 	}
-	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	replyCh, state, err := c.Pull(ctx, l, op, direct, 20*time.Second)
 	if err != nil {
-		f.log.WithField("op", "pull.one").Error(err)
-		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		labeled(ctx, f.log).WithField("op", "pull.one").Error(err)
+		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, pullError(err))
 	}
-	result := <-f.readOne(ctx, shard, id, replyCh, state)
+	if o.digests != nil {
+		defer func() { *o.digests = o.digestSet.byNode(state) }()
+	}
+	result := <-f.readOne(ctx, shard, id, replyCh, state, o)
+	mu.Lock()
+	if host := servedBy; result.Err == nil && host != "" {
+		f.setAffinity(shard, state.nodeName(host))
+	}
+	mu.Unlock()
 	if err = result.Err; err != nil {
 		err = fmt.Errorf("%s %q: %w", msgCLevel, l, err)
 		if strings.Contains(err.Error(), errConflictExistOrDeleted.Error()) {
 			err = objects.NewErrDirtyReadOfDeletedObject(err)
 		}
+	} else if l == One {
+		mu.Lock()
+		host := servedBy
+		mu.Unlock()
+		if o.maxAge > 0 {
+			result.Value = f.boundStaleness(ctx, shard, id, host, state.Hosts, result.Value, props, adds, o)
+		}
+		if f.oneVerificationRate > 0 && rand.Float64() < f.oneVerificationRate {
+			f.verifyOne(shard, id, host, state.Hosts, result.Value)
+		}
+	}
+	if err == nil && result.Value != nil {
+		node := ""
+		if state.Level == 1 {
+			mu.Lock()
+			node = servedBy
+			mu.Unlock()
+		}
+		f.auditRead(ctx, l, shard, node, result.Value)
+		if o.staleness != nil {
+			*o.staleness = o.stalenessOf(result.Value)
+		}
+		if o.soleSurvivor {
+			result.Value.IsConsistent = !o.soleRead.Load()
+		}
 	}
 	return result.Value, err
 }
 
+// wholeObject reports whether props and adds request the whole object without its
+// vectors, the only projection the local reader returns
+func wholeObject(props search.SelectProperties, adds additional.Properties) bool {
+	return len(props) == 0 && !adds.NoProps && !adds.Vector && len(adds.Vectors) == 0
+}
+
+// getOneDegraded reads an object like GetOne at level All, and at Quorum
+// if too few replicas replied to satisfy All
+func (f *Finder) getOneDegraded(ctx context.Context,
+	shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
+	opts []ReadOption,
+) (*storobj.Object, error) {
+	opts = append(opts[:len(opts):len(opts)], withoutDegrade())
+	obj, err := f.GetOne(ctx, All, shard, id, props, adds, opts...)
+	if err == nil || !errors.Is(err, errRead) && !errors.Is(err, errReplicas) {
+		return obj, err
+	}
+	labeled(ctx, f.log).WithField("op", "get").WithField("class", f.class).
+		WithField("shard", shard).WithField("uuid", id).
+		Warnf("reading at level %s: %v", Quorum, err)
+	return f.GetOne(ctx, Quorum, shard, id, props, adds, opts...)
+}
+
+// vectorNode returns the node designated for reads of shard returning vectors,
+// or an empty string if there is none or it is degraded
+func (f *Finder) vectorNode(shard string) string {
+	node := f.vectorNodes[shard]
+	if node == "" {
+		return ""
+	}
+	if host, ok := f.resolver.NodeHostname(node); !ok || f.breaker.isDegraded(host) || !f.healthy(node) {
+		return ""
+	}
+	return node
+}
+
+// healthy returns false if node is reported as unhealthy
+func (f *Finder) healthy(node string) bool {
+	return f.health == nil || f.health.Healthy(node)
+}
+
+// affineNode returns the node which last served a direct read of shard,
+// or an empty string if there is none or affinity is disabled
+func (f *Finder) affineNode(shard string) string {
+	if f.affinity == nil {
+		return ""
+	}
+	node, _ := f.affinity.Load(shard)
+	name, _ := node.(string)
+	if name != "" && !f.healthy(name) {
+		return ""
+	}
+	return name
+}
+
+// setAffinity remembers node as the node serving direct reads of shard.
+// An empty node forgets the current one.
+func (f *Finder) setAffinity(shard, node string) {
+	if f.affinity == nil {
+		return
+	}
+	if node == "" {
+		f.affinity.Delete(shard)
+		return
+	}
+	f.affinity.Store(shard, node)
+}
+
+// repairInBackground reads an object at level l with a detached context so
+// that stale replicas get repaired. Failures are logged, not returned.
+// The result is passed to o.onConfirmed, if set. The read keeps the caller's
+// options except for the outputs, which the caller may read once GetOne returned.
+func (f *Finder) repairInBackground(l ConsistencyLevel, shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
+	o readOptions,
+) {
+	f.goBackground(20*time.Second, func(ctx context.Context) {
+		obj, err := f.GetOne(ctx, l, shard, id, props, adds, withOptions(o.background()))
+		if err != nil {
+			labeled(ctx, f.log).WithField("op", "background_repair").WithField("class", f.class).
+				WithField("shard", shard).WithField("uuid", id).Error(err)
+		}
+		if o.onConfirmed != nil {
+			o.onConfirmed(obj, err)
+		}
+	})
+}
+
+// boundStaleness returns obj, read from host at level ONE, unless the digest of another
+// replica shows a version more than o.maxAge more recent, which is then read and returned.
+// If the other replica cannot be read or reports another generation, obj is returned.
+func (f *Finder) boundStaleness(ctx context.Context,
+	shard string, id strfmt.UUID,
+	host string, hosts []string, obj *storobj.Object,
+	props search.SelectProperties, adds additional.Properties,
+	o readOptions,
+) *storobj.Object {
+	peer := ""
+	for _, h := range hosts {
+		if h != host {
+			peer = h
+			break
+		}
+	}
+	if peer == "" {
+		return obj
+	}
+	logger := labeled(ctx, f.log).WithField("op", "bound_staleness").WithField("class", f.class).
+		WithField("shard", shard).WithField("uuid", id).WithField("replica", peer)
+	xs, err := f.client.DigestReads(ctx, peer, f.class, shard, []strfmt.UUID{id}, 0)
+	if err == nil {
+		err = o.checkGeneration(peer, xs)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return obj
+	}
+	var uTime int64
+	if obj != nil {
+		uTime = obj.LastUpdateTimeUnix()
+	}
+	if xs[0].UpdateTime-uTime <= o.maxAge.Milliseconds() {
+		return obj
+	}
+	if xs[0].Deleted {
+		return nil
+	}
+	r, err := f.client.FullRead(ctx, peer, f.class, shard, id, props, adds, 0)
+	if err == nil {
+		err = o.checkGenerationOf(peer, r.Generation)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return obj
+	}
+	if r.Object == nil || r.UpdateTime() <= uTime {
+		return obj
+	}
+	logger.Debugf("object read from %s is %dms behind, returning the version of %s",
+		host, r.UpdateTime()-uTime, peer)
+	return r.Object
+}
+
+// verifyOne compares in the background the object served by host with the
+// digest of another replica. Mismatches are logged, the read is not affected.
+func (f *Finder) verifyOne(shard string, id strfmt.UUID,
+	host string, hosts []string, obj *storobj.Object,
+) {
+	peer := ""
+	for _, h := range hosts {
+		if h != host {
+			peer = h
+			break
+		}
+	}
+	if peer == "" {
+		return
+	}
+	var uTime int64
+	if obj != nil {
+		uTime = obj.LastUpdateTimeUnix()
+	}
+	f.goBackground(20*time.Second, func(ctx context.Context) {
+		xs, err := f.client.DigestReads(ctx, peer, f.class, shard, []strfmt.UUID{id}, 0)
+		logger := labeled(ctx, f.log).WithField("op", "verify_one").WithField("class", f.class).
+			WithField("shard", shard).WithField("uuid", id)
+		if err != nil {
+			logger.WithField("replica", peer).Debug(err)
+			return
+		}
+		if x := xs[0]; x.UpdateTime != uTime && !(obj == nil && x.Deleted) {
+			logger.WithField("msg", fmt.Sprintf("%s:%d %s:%d", host, uTime, peer, x.UpdateTime)).
+				Warn("replica mismatch detected by read verification")
+		}
+	})
+}
+
 func (f *Finder) FindUUIDs(ctx context.Context,
 	className, shard string, filters *filters.LocalFilter, l ConsistencyLevel,
 ) (uuids []strfmt.UUID, err error) {
@@ -149,8 +543,8 @@ func (f *Finder) FindUUIDs(ctx context.Context,
 
 	replyCh, _, err := c.Pull(ctx, l, op, "", 30*time.Second)
 	if err != nil {
-		f.log.WithField("op", "pull.one").Error(err)
-		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		labeled(ctx, f.log).WithField("op", "pull.one").Error(err)
+		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, pullError(err))
 	}
 
 	res := make(map[strfmt.UUID]struct{})
@@ -175,6 +569,19 @@ func (f *Finder) FindUUIDs(ctx context.Context,
 	return uuids, err
 }
 
+// pullError maps an error returned by the coordinator's Pull to the error
+// reported to the caller. All returned errors match errReplicas.
+func pullError(err error) error {
+	switch {
+	case errors.Is(err, errUnknownShard):
+		return errUnknownShard
+	case errors.Is(err, errNoReplicaFound):
+		return errNoLiveReplicas
+	default:
+		return errReplicas
+	}
+}
+
 type ShardDesc struct {
 	Name string
 	Node string
@@ -182,9 +589,12 @@ type ShardDesc struct {
 
 // CheckConsistency for objects belonging to different physical shards.
 //
-// For each x in xs the fields BelongsToNode and BelongsToShard must be set non empty
+// For each x in xs the fields BelongsToNode and BelongsToShard must be set non empty.
+// An object id occurring more than once in a shard is read once, and all its
+// positions in xs are set to the same resolved object.
 func (f *Finder) CheckConsistency(ctx context.Context,
 	l ConsistencyLevel, xs []*storobj.Object,
+	opts ...ReadOption,
 ) (retErr error) {
 	if len(xs) == 0 {
 		return nil
@@ -198,6 +608,41 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 		}
 	}
 
+	if l == One { // already consistent
+		for i := range xs {
+			xs[i].IsConsistent = true
+		}
+		return nil
+	}
+	o := newReadOptions(opts)
+	ctx, countDone := o.countRPCs(ctx)
+	defer countDone()
+	if f.repairErrors {
+		o.failures = &repairFailures{m: make(map[strfmt.UUID]error)}
+		defer func() {
+			if retErr == nil {
+				retErr = o.failures.err()
+			}
+		}()
+	}
+	if o.acks != nil {
+		defer func() { *o.acks = o.ackSet.sorted() }()
+	}
+	if o.winners != nil {
+		defer func() { *o.winners = o.winnerMap.copy() }()
+	}
+	if o.staleness != nil {
+		defer func() { *o.staleness = o.stalenessOf(xs...) }()
+	}
+	return f.checkConsistency(ctx, l, xs, o)
+}
+
+// checkConsistency is CheckConsistency for objects validated by the caller,
+// with the read options o whose collectors the caller reports
+func (f *Finder) checkConsistency(ctx context.Context,
+	l ConsistencyLevel, xs []*storobj.Object,
+	o readOptions,
+) error {
 	if l == One { // already consistent
 		for i := range xs {
 			xs[i].IsConsistent = true
@@ -208,28 +653,468 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
 	for _, part := range cluster(createBatch(xs)) {
 		part := part
+		dups := part.dedup() // ids passed more than once are read once
 		gr.Go(func() error {
-			_, err := f.checkShardConsistency(ctx, l, part)
+			_, err := f.checkShardConsistency(ctx, l, part, o)
 			if err != nil {
-				f.log.WithField("op", "check_shard_consistency").
+				labeled(ctx, f.log).WithField("op", "check_shard_consistency").
 					WithField("shard", part.Shard).Error(err)
+				return err
 			}
-			return err
+			part.expand(dups)
+			return nil
 		}, part)
 	}
 	return gr.Wait()
 }
 
+// GetAllDigest returns, for each of ids, the digest of the most recent version held by
+// the replicas read at consistency level l. Only digests are read: no object content is
+// transferred and no replica is repaired, which lets clients cheaply detect changes by
+// comparing update times against a cached set. Missing objects have an update time of 0.
+func (f *Finder) GetAllDigest(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	ids []strfmt.UUID,
+	opts ...ReadOption,
+) ([]RepairResponse, error) {
+	o := newReadOptions(opts)
+	c := newReadCoordinator[batchReply](f, shard,
+		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	c.withReadOptions(o)
+	op := func(ctx context.Context, host string, _ bool) (batchReply, error) {
+		xs, err := f.client.DigestReads(ctx, host, f.class, shard, ids, 0)
+		if err == nil {
+			err = o.checkGeneration(host, xs)
+		}
+		return batchReply{Sender: host, IsDigest: true, DigestData: xs}, err
+	}
+	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	if err != nil {
+		labeled(ctx, f.log).WithField("op", "pull.digest").Error(err)
+		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, pullError(err))
+	}
+	var latest []RepairResponse
+	for r := range replyCh { // len(replyCh) == state.Level
+		if r.Err != nil {
+			labeled(ctx, f.log).WithField("op", "get_all_digest").WithField("replica", r.Value.Sender).
+				WithField("class", f.class).WithField("shard", shard).Error(r.Err)
+			return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, state.readError())
+		}
+		if latest == nil {
+			latest = append(make([]RepairResponse, 0, len(ids)), r.Value.DigestData...)
+			continue
+		}
+		for i, x := range r.Value.DigestData {
+			if f.compare(x, latest[i]) > 0 {
+				latest[i] = x
+			}
+		}
+	}
+	return latest, nil
+}
+
+// GetAll reads the objects ids of shard at consistency level l, window ids at a time.
+// The objects of each window are read from one replica, checked and repaired as by
+// CheckConsistency, and passed to emit before the next window is read, so that at most
+// window objects are held at once however many ids are given. Objects are emitted in
+// the order of ids, unless set otherwise WithOrder. Objects absent or deleted on the
+// replica read are read again in a single batch per window, see readMissing, unless
+// l is One, and skipped if no replica holds them. Options collecting results, e.g.
+// WithAcks, report all windows read.
+// GetAll stops at the first error, including one returned by emit, except for objects
+// which could not be repaired: their windows are emitted and their failures are
+// returned in a single RepairError once all windows have been read (see RetryFailed).
+// A window <= 0 reads all ids at once.
+func (f *Finder) GetAll(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	ids []strfmt.UUID, window int,
+	emit func(objs []*storobj.Object) error,
+	opts ...ReadOption,
+) (retErr error) {
+	if window <= 0 {
+		window = len(ids)
+	}
+	o := newReadOptions(opts)
+	ctx, countDone := o.countRPCs(ctx)
+	defer countDone()
+	if f.repairErrors {
+		o.failures = &repairFailures{m: make(map[strfmt.UUID]error)}
+		defer func() {
+			if retErr == nil {
+				retErr = o.failures.err()
+			}
+		}()
+	}
+	if o.repairIncomplete != nil {
+		defer func() { *o.repairIncomplete = o.incomplete.Load() }()
+	}
+	if o.acks != nil {
+		defer func() { *o.acks = o.ackSet.sorted() }()
+	}
+	if o.winners != nil {
+		defer func() { *o.winners = o.winnerMap.copy() }()
+	}
+	var staleness map[strfmt.UUID]time.Duration
+	if o.staleness != nil {
+		staleness = make(map[strfmt.UUID]time.Duration)
+		defer func() { *o.staleness = staleness }()
+	}
+	rounds := 0 // windows in which replicas were repaired
+	for start := 0; start < len(ids); start += window {
+		end := min(start+window, len(ids))
+		o := o
+		if o.maxRepairRounds > 0 {
+			o.noRepair = rounds >= o.maxRepairRounds
+			o.repairRound = &atomic.Bool{}
+		}
+		read, err := f.readWindow(ctx, shard, ids[start:end], o)
+		if err != nil {
+			return fmt.Errorf("read objects %d to %d: %w", start, end, err)
+		}
+		if l != One {
+			if err := f.readMissing(ctx, l, shard, ids[start:end], read, o); err != nil {
+				return fmt.Errorf("read missing objects %d to %d: %w", start, end, err)
+			}
+		}
+		if err := f.checkWindow(ctx, l, read, o); err != nil {
+			return err
+		}
+		if o.repairRound != nil && o.repairRound.Load() {
+			rounds++
+		}
+		xs := make([]*storobj.Object, 0, len(read))
+		for _, x := range read {
+			if x != nil {
+				xs = append(xs, x)
+			}
+		}
+		if len(xs) == 0 {
+			continue
+		}
+		if staleness != nil {
+			maps.Copy(staleness, o.stalenessOf(xs...))
+		}
+		o.order.sort(xs)
+		if err := emit(xs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryFailed reads again, as GetAll does, the objects of shard listed by prevErr,
+// a RepairError returned by GetAll or CheckConsistency, at consistency level l.
+// Only the objects which could not be repaired are requested. It returns nil
+// if prevErr is nil, and an error if prevErr does not list failed objects.
+func (f *Finder) RetryFailed(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	prevErr error,
+	opts ...ReadOption,
+) ([]*storobj.Object, error) {
+	if prevErr == nil {
+		return nil, nil
+	}
+	var repErr *RepairError
+	if !errors.As(prevErr, &repErr) {
+		return nil, fmt.Errorf("no failed objects to retry: %w", prevErr)
+	}
+	var objs []*storobj.Object
+	err := f.GetAll(ctx, l, shard, repErr.IDs(), 0, func(xs []*storobj.Object) error {
+		objs = append(objs, xs...)
+		return nil
+	}, opts...)
+	return objs, err
+}
+
+// readWindow reads the objects ids of shard from a single replica.
+// The i-th object read is that of ids[i], nil if it is absent or deleted.
+func (f *Finder) readWindow(ctx context.Context,
+	shard string, ids []strfmt.UUID,
+	o readOptions,
+) ([]*storobj.Object, error) {
+	c := newReadCoordinator[batchReply](f, shard,
+		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	c.withReadOptions(o)
+	op := func(ctx context.Context, host string, _ bool) (batchReply, error) {
+		xs, err := f.client.FullReads(ctx, host, f.class, shard, ids)
+		if errors.Is(err, errEmptyReply) {
+			xs, err = nil, nil // none of the objects exists on host
+		}
+		for i := 0; err == nil && i < len(xs); i++ {
+			err = o.checkGenerationOf(host, xs[i].Generation)
+		}
+		return batchReply{Sender: host, FullData: xs}, err
+	}
+	replyCh, state, err := c.Pull(ctx, One, op, f.affineNode(shard), 20*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("pull shard: %w", pullError(err))
+	}
+	var xs []*storobj.Object
+	for r := range replyCh { // len(replyCh) == 1
+		if r.Err != nil {
+			labeled(ctx, f.log).WithField("op", "read_window").WithField("replica", r.Value.Sender).
+				WithField("class", f.class).WithField("shard", shard).Error(r.Err)
+			return nil, state.readError()
+		}
+		node := state.nodeName(r.Value.Sender)
+		xs = make([]*storobj.Object, len(ids))
+		for i, x := range r.Value.FullData {
+			if x.Deleted || x.Object == nil {
+				continue
+			}
+			x.Object.BelongsToNode, x.Object.BelongsToShard = node, shard
+			xs[i] = x.Object
+		}
+	}
+	return xs, nil
+}
+
+// readMissing reads at level l the objects of ids which are nil in read, i.e. absent or
+// deleted on the replica read by readWindow, and sets them in read. Their digests are
+// read in a single batch and each object is fetched from a replica holding its most
+// recent version, with one request per replica. Objects whose most recent version is
+// a tombstone, or which no replica holds, are left nil.
+func (f *Finder) readMissing(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	ids []strfmt.UUID, read []*storobj.Object,
+	o readOptions,
+) error {
+	var missing []int // indexes of the missing objects in ids
+	for i, x := range read {
+		if x == nil {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	query := make([]strfmt.UUID, len(missing))
+	for j, i := range missing {
+		query[j] = ids[i]
+	}
+	c := newReadCoordinator[batchReply](f, shard,
+		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	c.withReadOptions(o)
+	op := func(ctx context.Context, host string, _ bool) (batchReply, error) {
+		xs, err := f.client.DigestReads(ctx, host, f.class, shard, query, 0)
+		if errors.Is(err, errEmptyReply) {
+			err = nil // none of the objects exists on host, see batchReply.aligned
+		}
+		if err == nil {
+			err = o.checkGeneration(host, xs)
+		}
+		return batchReply{Sender: host, IsDigest: true, DigestData: xs}, err
+	}
+	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	if err != nil {
+		return fmt.Errorf("pull shard: %w", pullError(err))
+	}
+	var (
+		latest = make([]RepairResponse, len(query))
+		holder = make([]string, len(query)) // host holding latest, if any
+	)
+	for r := range replyCh { // len(replyCh) == state.Level
+		reply, err := r.Value, r.Err
+		if err == nil {
+			reply, err = reply.aligned(query)
+		}
+		if err != nil {
+			labeled(ctx, f.log).WithField("op", "read_missing").WithField("replica", r.Value.Sender).
+				WithField("class", f.class).WithField("shard", shard).Error(err)
+			return state.readError()
+		}
+		for j, x := range reply.DigestData {
+			if x.UpdateTime == 0 && !x.Deleted {
+				continue // absent on the sender
+			}
+			if holder[j] == "" || f.compare(x, latest[j]) > 0 {
+				latest[j], holder[j] = x, reply.Sender
+			}
+		}
+	}
+	byHost := make(map[string][]int) // indexes in query of the objects to fetch from each host
+	var hosts []string
+	for j, host := range holder {
+		if host == "" || latest[j].Deleted {
+			continue
+		}
+		if _, ok := byHost[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		byHost[host] = append(byHost[host], j)
+	}
+	for _, host := range hosts {
+		js := byHost[host]
+		fetch := make([]strfmt.UUID, len(js))
+		for k, j := range js {
+			fetch[k] = query[j]
+		}
+		xs, err := f.client.FullReads(ctx, host, f.class, shard, fetch)
+		for k := 0; err == nil && k < len(xs); k++ {
+			err = o.checkGenerationOf(host, xs[k].Generation)
+		}
+		if err != nil {
+			return fmt.Errorf("fetch from %s: %w", host, err)
+		}
+		node := state.nodeName(host)
+		for k, x := range xs {
+			if x.Deleted || x.Object == nil {
+				continue // deleted since its digest was read
+			}
+			x.Object.BelongsToNode, x.Object.BelongsToShard = node, shard
+			read[missing[js[k]]] = x.Object
+		}
+	}
+	return nil
+}
+
+// checkWindow checks the consistency of the objects of read which are not nil, like
+// CheckConsistency does, reporting to the collectors of o. Objects are checked
+// separately for each node they were read from, and replaced in read by the version
+// the check resolved.
+func (f *Finder) checkWindow(ctx context.Context,
+	l ConsistencyLevel, read []*storobj.Object,
+	o readOptions,
+) error {
+	var (
+		nodes  []string
+		byNode = make(map[string][]int) // indexes in read of the objects read from each node
+	)
+	for i, x := range read {
+		if x == nil {
+			continue
+		}
+		if _, ok := byNode[x.BelongsToNode]; !ok {
+			nodes = append(nodes, x.BelongsToNode)
+		}
+		byNode[x.BelongsToNode] = append(byNode[x.BelongsToNode], i)
+	}
+	for _, node := range nodes {
+		idx := byNode[node]
+		xs := make([]*storobj.Object, len(idx))
+		for k, i := range idx {
+			xs[k] = read[i]
+		}
+		if err := f.checkConsistency(ctx, l, xs, o); err != nil {
+			return err
+		}
+		for k, i := range idx {
+			read[i] = xs[k]
+		}
+	}
+	return nil
+}
+
 // Exists checks if an object exists which satisfies the giving consistency
 func (f *Finder) Exists(ctx context.Context,
 	l ConsistencyLevel,
 	shard string,
 	id strfmt.UUID,
+	opts ...ReadOption,
+) (bool, error) {
+	o := newReadOptions(opts)
+	ctx, countDone := o.countRPCs(ctx)
+	defer countDone()
+	if l == One && o.minAcks <= 1 && o.maxFailures < 0 && f.isLocalReplica(shard) {
+		xs, err := f.local.DigestObjects(ctx, shard, []strfmt.UUID{id})
+		if err == nil && len(xs) == 1 && (xs[0].UpdateTime != 0 || xs[0].Deleted) &&
+			o.checkGeneration(f.resolver.NodeName, xs) == nil {
+			return !xs[0].Deleted, nil
+		}
+		// not available locally, fall back to remote replicas
+	}
+	result, err := f.readExists(ctx, l, shard, id, o)
+	return result.Exists, err
+}
+
+// ExistsOrGet checks if an object exists which satisfies the giving consistency
+// and, if so, returns its content. Unlike Exists followed by GetOne, it takes a
+// single digest round and at most one full read from a replica holding the most
+// recent version. If replicas disagree, the object fetched to repair them is returned.
+func (f *Finder) ExistsOrGet(ctx context.Context,
+	l ConsistencyLevel,
+	shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
+	opts ...ReadOption,
+) (bool, *storobj.Object, error) {
+	result, err := f.readExists(ctx, l, shard, id, newReadOptions(opts))
+	if err != nil || !result.Exists {
+		return false, nil, err
+	}
+	if result.Object != nil { // fetched while repairing
+		return true, result.Object, nil
+	}
+	resp, err := f.client.FullRead(ctx, result.Sender, f.class, shard, id, props, adds, 9)
+	if err != nil {
+		return true, nil, fmt.Errorf("fetch object from %s: %w", result.Sender, err)
+	}
+	if resp.Deleted || resp.Object == nil || resp.UpdateTime() != result.UpdateTime {
+		return true, nil, fmt.Errorf("fetch object from %s: %w", result.Sender, errConflictObjectChanged)
+	}
+	return true, resp.Object, nil
+}
+
+// ExistsVerified is Exists, except that an object the replicas agree exists is only
+// reported as existing once a replica has served the agreed version of its content.
+// It catches replicas whose digests diverge from the content they can serve: replicas
+// are tried in turn, starting with the one which reported the agreed version, and
+// false is returned if none of them can serve the object.
+func (f *Finder) ExistsVerified(ctx context.Context,
+	l ConsistencyLevel,
+	shard string,
+	id strfmt.UUID,
+	opts ...ReadOption,
 ) (bool, error) {
+	result, err := f.readExists(ctx, l, shard, id, newReadOptions(opts))
+	if err != nil || !result.Exists {
+		return false, err
+	}
+	if result.Object != nil { // fetched while repairing
+		return true, nil
+	}
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w : class %q shard %q", errUnknownShard, err, f.class, shard)
+	}
+	hosts := append(make([]string, 0, len(nodes)), result.Sender)
+	for _, host := range nodes {
+		if host != "" && host != result.Sender {
+			hosts = append(hosts, host)
+		}
+	}
+	for _, host := range hosts {
+		resp, err := f.client.FullRead(ctx, host, f.class, shard, id,
+			search.SelectProperties{}, additional.Properties{}, 9)
+		if err == nil && !resp.Deleted && resp.Object != nil && resp.UpdateTime() == result.UpdateTime {
+			return true, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		labeled(ctx, f.log).WithField("op", "exists_verified").WithField("replica", host).
+			WithField("class", f.class).WithField("shard", shard).WithField("uuid", id).
+			Debugf("replica cannot serve object reported to exist: %v", err)
+	}
+	return false, nil
+}
+
+// readExists checks the existence of object id on the replicas of shard
+func (f *Finder) readExists(ctx context.Context,
+	l ConsistencyLevel,
+	shard string,
+	id strfmt.UUID,
+	o readOptions,
+) (existResult, error) {
 	c := newReadCoordinator[existReply](f, shard,
 		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	c.withReadOptions(o)
 	op := func(ctx context.Context, host string, _ bool) (existReply, error) {
 		xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id}, 0)
+		if err == nil {
+			err = o.checkGeneration(host, xs)
+		}
 		var x RepairResponse
 		if len(xs) == 1 {
 			x = xs[0]
@@ -238,10 +1123,10 @@ func (f *Finder) Exists(ctx context.Context,
 	}
 	replyCh, state, err := c.Pull(ctx, l, op, "", 20*time.Second)
 	if err != nil {
-		f.log.WithField("op", "pull.exist").Error(err)
-		return false, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		labeled(ctx, f.log).WithField("op", "pull.exist").Error(err)
+		return existResult{}, fmt.Errorf("%s %q: %w", msgCLevel, l, pullError(err))
 	}
-	result := <-f.readExistence(ctx, shard, id, replyCh, state)
+	result := <-f.readExistence(ctx, shard, id, replyCh, state, o)
 	if err = result.Err; err != nil {
 		err = fmt.Errorf("%s %q: %w", msgCLevel, l, err)
 		if strings.Contains(err.Error(), errConflictExistOrDeleted.Error()) {
@@ -251,6 +1136,363 @@ func (f *Finder) Exists(ctx context.Context,
 	return result.Value, err
 }
 
+// isLocalReplica reports whether this node holds a replica of shard
+// which can be read through the local reader
+func (f *Finder) isLocalReplica(shard string) bool {
+	if f.local == nil {
+		return false
+	}
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	return err == nil && nodes[f.resolver.NodeName] != ""
+}
+
+// ObjectVersions returns the update time of object id on each replica of shard.
+// An update time of 0 means the object does not exist on the node.
+// The object is not repaired; it is used mainly for debugging purposes
+func (f *Finder) ObjectVersions(ctx context.Context,
+	shard string,
+	id strfmt.UUID,
+) (map[string]int64, error) {
+	state, err := f.resolver.State(shard, One, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, f.class, shard)
+	}
+	var (
+		mu       sync.Mutex
+		versions = make(map[string]int64, len(state.NodeMap))
+	)
+	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
+	for name, host := range state.NodeMap {
+		if name == "" || host == "" {
+			continue
+		}
+		name, host := name, host
+		gr.Go(func() error {
+			xs, err := f.client.DigestReads(ctx, host, f.class, shard, []strfmt.UUID{id}, 0)
+			if err != nil {
+				return fmt.Errorf("node %q: %w", name, err)
+			}
+			mu.Lock()
+			versions[name] = xs[0].UpdateTime
+			mu.Unlock()
+			return nil
+		})
+	}
+	return versions, gr.Wait()
+}
+
+// ToleratedFailures returns the maximum number of replicas of shard which
+// may fail without failing a read at consistency level l.
+// Reads exceeding it fail with an error reporting this number.
+func (f *Finder) ToleratedFailures(l ConsistencyLevel, shard string) (int, error) {
+	state, err := f.resolver.State(shard, l, "")
+	if err != nil {
+		return 0, fmt.Errorf("%w : class %q shard %q", err, f.class, shard)
+	}
+	return state.ToleratedFailures(), nil
+}
+
+// RestoreNode clears the degraded flag set on node by the staleness breaker
+// (see WithStalenessBreaker), so that it serves direct reads again.
+func (f *Finder) RestoreNode(node string) {
+	if host, ok := f.resolver.NodeHostname(node); ok {
+		f.breaker.restore(host)
+	}
+}
+
+// WarmReplicas sends an empty digest request to each replica of shard, so that
+// connections are established before a burst of reads.
+// It returns the names of the reachable nodes, sorted, and an error
+// listing the unreachable ones, if any.
+func (f *Finder) WarmReplicas(ctx context.Context, shard string) ([]string, error) {
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w : class %q shard %q", errUnknownShard, err, f.class, shard)
+	}
+	var (
+		mu        sync.Mutex
+		reachable = make([]string, 0, len(nodes))
+		errs      []error
+		wg        sync.WaitGroup
+	)
+	for name, host := range nodes {
+		if host == "" {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("node %q: %w", name, errUnresolvedName))
+			mu.Unlock()
+			continue
+		}
+		name, host := name, host
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			_, err := f.client.DigestReads(ctx, host, f.class, shard, nil, 0)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("node %q: %w", name, err))
+				return
+			}
+			reachable = append(reachable, name)
+		}, f.logger)
+	}
+	wg.Wait()
+	sort.Strings(reachable)
+	return reachable, errors.Join(errs...)
+}
+
+// FeasibleLevels probes the replicas of shard and returns the named consistency
+// levels which reads could currently satisfy, strongest first, given the nodes
+// which are reachable right now. Clients can use it to pick the strongest level
+// available. The result is only a snapshot: nodes may fail or recover right after.
+func (f *Finder) FeasibleLevels(ctx context.Context, shard string) ([]ConsistencyLevel, error) {
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w : class %q shard %q", errUnknownShard, err, f.class, shard)
+	}
+	// unreachable nodes are expected here, they just make levels infeasible
+	live, err := f.WarmReplicas(ctx, shard)
+	if errors.Is(err, errUnknownShard) {
+		return nil, err
+	}
+	levels := make([]ConsistencyLevel, 0, 3)
+	for _, l := range []ConsistencyLevel{All, Quorum, One} {
+		if n := len(live); n > 0 && n >= cLevel(l, len(nodes)) {
+			levels = append(levels, l)
+		}
+	}
+	return levels, nil
+}
+
+// ValidateTopology asks each replica of shard which nodes it believes replicate the
+// shard and returns an error describing every replica whose view differs from the
+// view of this node, e.g. after a split-brain. It is a health check and is not used
+// by reads. The client must implement TopologyReader.
+func (f *Finder) ValidateTopology(ctx context.Context, shard string) error {
+	tr, ok := f.client.cl.(TopologyReader)
+	if !ok {
+		return fmt.Errorf("%w: client cannot read replica topology", errTopology)
+	}
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return fmt.Errorf("%w: %w : class %q shard %q", errUnknownShard, err, f.class, shard)
+	}
+	want := make([]string, 0, len(nodes))
+	for name := range nodes {
+		want = append(want, name)
+	}
+	sort.Strings(want)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	for name, host := range nodes {
+		if host == "" {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("node %q: %w", name, errUnresolvedName))
+			mu.Unlock()
+			continue
+		}
+		name, host := name, host
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			peers, err := tr.ShardReplicas(ctx, host, f.class, shard)
+			if err == nil {
+				peers = slices.Clone(peers)
+				sort.Strings(peers)
+				if !slices.Equal(peers, want) {
+					err = fmt.Errorf("%w: reports replicas %v, expected %v", errTopology, peers, want)
+				}
+			}
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("node %q: %w", name, err))
+				mu.Unlock()
+			}
+		}, f.logger)
+	}
+	wg.Wait()
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
+}
+
+// ReadReplicasRaw returns the digests of the objects ids held by each replica of shard,
+// keyed by node name. It is strictly read-only: no consensus is computed and nothing
+// is fetched or repaired, which makes it suitable for replica verification jobs.
+// The digests of reachable nodes are returned along with an error listing the others.
+func (f *Finder) ReadReplicasRaw(ctx context.Context,
+	shard string, ids []strfmt.UUID,
+) (map[string][]RepairResponse, error) {
+	nodes, err := f.resolver.Schema.ResolveParentNodes(f.class, shard)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w : class %q shard %q", errUnknownShard, err, f.class, shard)
+	}
+	var (
+		mu      sync.Mutex
+		digests = make(map[string][]RepairResponse, len(nodes))
+		errs    []error
+		wg      sync.WaitGroup
+	)
+	for name, host := range nodes {
+		if host == "" {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("node %q: %w", name, errUnresolvedName))
+			mu.Unlock()
+			continue
+		}
+		name, host := name, host
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			xs, err := f.client.DigestReads(ctx, host, f.class, shard, ids, 0)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("node %q: %w", name, err))
+				return
+			}
+			digests[name] = xs
+		}, f.logger)
+	}
+	wg.Wait()
+	return digests, errors.Join(errs...)
+}
+
+// ExistsEverywhere digests object id on every replica of shard and reports whether
+// it is present on all of them, along with its presence on each node keyed by node name.
+// Deleted objects count as absent. Unlike Exists at level All, no replica is repaired.
+// If some nodes cannot be read, the presence on the others is returned with an error.
+func (f *Finder) ExistsEverywhere(ctx context.Context,
+	shard string, id strfmt.UUID,
+) (bool, map[string]bool, error) {
+	digests, err := f.ReadReplicasRaw(ctx, shard, []strfmt.UUID{id})
+	present := make(map[string]bool, len(digests))
+	all := err == nil
+	for node, xs := range digests {
+		present[node] = len(xs) == 1 && xs[0].UpdateTime != 0 && !xs[0].Deleted
+		all = all && present[node]
+	}
+	return all, present, err
+}
+
+// ForceOverwrite sends objs to each of the given replicas of shard as read repair
+// would, without comparing digests first, e.g. to bootstrap replicas from an
+// authoritative copy during a migration. As with repairs, replicas reject the
+// overwrite of an object whose update time differs from its StaleUpdateTime.
+// The repair byte limit does not apply. It returns the outcome per node name,
+// nil meaning that all objects have been written, and an error if any node failed.
+func (f *Finder) ForceOverwrite(ctx context.Context,
+	shard string, objs []*objects.VObject, nodes []string,
+) (map[string]error, error) {
+	var (
+		mu      sync.Mutex
+		results = make(map[string]error, len(nodes))
+		wg      sync.WaitGroup
+	)
+	for _, name := range nodes {
+		host, ok := f.resolver.NodeHostname(name)
+		if !ok || host == "" {
+			mu.Lock()
+			results[name] = errUnresolvedName
+			mu.Unlock()
+			continue
+		}
+		name := name
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			start := time.Now()
+			rs, err := f.client.cl.OverwriteObjects(ctx, host, f.class, shard, objs)
+			f.client.observe(ctx, host, "OverwriteObjects", start)
+			if err == nil {
+				var errs []error
+				for _, x := range rs {
+					if x.Err != "" {
+						errs = append(errs, fmt.Errorf("object %s: %s", x.ID, x.Err))
+					}
+				}
+				err = errors.Join(errs...)
+			}
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}, f.logger)
+	}
+	wg.Wait()
+
+	var failed []string
+	for name, err := range results {
+		if err != nil {
+			failed = append(failed, name)
+		}
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return results, fmt.Errorf("%w: overwrite failed on nodes %v", errRepair, failed)
+	}
+	return results, nil
+}
+
+// NodeDiff compares the versions of objects held by two replicas A and B
+type NodeDiff struct {
+	// AAhead lists objects for which A holds a more recent version than B
+	AAhead []strfmt.UUID
+	// BAhead lists objects for which B holds a more recent version than A
+	BAhead []strfmt.UUID
+	// Equal lists objects for which both hold the same version
+	Equal []strfmt.UUID
+}
+
+// CompareNodes digests the objects ids of shard on exactly the nodes a and b
+// and reports which of them holds the more recent version of each object.
+// Nothing is repaired.
+func (f *Finder) CompareNodes(ctx context.Context,
+	shard string, ids []strfmt.UUID, a, b string,
+) (NodeDiff, error) {
+	var (
+		names   = [2]string{a, b}
+		hosts   [2]string
+		digests [2][]RepairResponse
+	)
+	for i, name := range names {
+		host, ok := f.resolver.NodeHostname(name)
+		if !ok || host == "" {
+			return NodeDiff{}, fmt.Errorf("node %q: %w", name, errUnresolvedName)
+		}
+		hosts[i] = host
+	}
+	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
+	for i, name := range names {
+		i, name, host := i, name, hosts[i]
+		gr.Go(func() (err error) {
+			digests[i], err = f.client.DigestReads(ctx, host, f.class, shard, ids, 0)
+			if err != nil {
+				return fmt.Errorf("node %q: %w", name, err)
+			}
+			return nil
+		})
+	}
+	if err := gr.Wait(); err != nil {
+		return NodeDiff{}, err
+	}
+
+	var diff NodeDiff
+	for i, id := range ids {
+		switch c := f.compare(digests[0][i], digests[1][i]); {
+		case c > 0:
+			diff.AAhead = append(diff.AAhead, id)
+		case c < 0:
+			diff.BAhead = append(diff.BAhead, id)
+		default:
+			diff.Equal = append(diff.Equal, id)
+		}
+	}
+	return diff, nil
+}
+
 // NodeObject gets object from a specific node.
 // it is used mainly for debugging purposes
 func (f *Finder) NodeObject(ctx context.Context,
@@ -267,11 +1509,57 @@ func (f *Finder) NodeObject(ctx context.Context,
 	return r.Object, err
 }
 
+// NodeObjectAny fetches an object from all given nodes concurrently and returns the first
+// successful reply, cancelling the remaining requests. It fails only if all nodes fail,
+// in which case the error names each node along with its error.
+func (f *Finder) NodeObjectAny(ctx context.Context,
+	nodes []string,
+	shard string,
+	id strfmt.UUID,
+	props search.SelectProperties, adds additional.Properties,
+) (*storobj.Object, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no nodes to read %s from", id)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type reply struct {
+		node string
+		obj  *storobj.Object
+		err  error
+	}
+	replies := make(chan reply, len(nodes))
+	for _, node := range nodes {
+		node := node
+		host, ok := f.resolver.NodeHostname(node)
+		if !ok || host == "" {
+			replies <- reply{node: node, err: fmt.Errorf("cannot resolve node name")}
+			continue
+		}
+		enterrors.GoWrapper(func() {
+			r, err := f.client.FullRead(ctx, host, f.class, shard, id, props, adds, 9)
+			replies <- reply{node, r.Object, err}
+		}, f.logger)
+	}
+
+	errs := make([]string, 0, len(nodes))
+	for range nodes {
+		r := <-replies
+		if r.err == nil {
+			return r.obj, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", r.node, r.err))
+	}
+	return nil, fmt.Errorf("read %s from nodes: %s", id, strings.Join(errs, "; "))
+}
+
 // checkShardConsistency checks consistency for a set of objects belonging to a shard
 // It returns the most recent objects or and error
 func (f *Finder) checkShardConsistency(ctx context.Context,
 	l ConsistencyLevel,
 	batch shardPart,
+	o readOptions,
 ) ([]*storobj.Object, error) {
 	var (
 		c = newReadCoordinator[batchReply](f, batch.Shard,
@@ -279,20 +1567,44 @@ func (f *Finder) checkShardConsistency(ctx context.Context,
 		shard     = batch.Shard
 		data, ids = batch.Extract() // extract from current content
 	)
+	c.withReadOptions(o)
 	op := func(ctx context.Context, host string, fullRead bool) (batchReply, error) {
 		if fullRead { // we already have the content
 			return batchReply{Sender: host, IsDigest: false, FullData: data}, nil
 		} else {
 			xs, err := f.client.DigestReads(ctx, host, f.class, shard, ids, 0)
+			if errors.Is(err, errEmptyReply) {
+				err = nil // none of the objects exists on host, see batchReply.aligned
+			}
+			if err == nil {
+				err = o.checkGeneration(host, xs)
+			}
 			return batchReply{Sender: host, IsDigest: true, DigestData: xs}, err
 		}
 	}
 
 	replyCh, state, err := c.Pull(ctx, l, op, batch.Node, 20*time.Second)
 	if err != nil {
-		return nil, fmt.Errorf("pull shard: %w", errReplicas)
+		return nil, fmt.Errorf("pull shard: %w", pullError(err))
+	}
+	result := <-f.readBatchPart(ctx, batch, ids, replyCh, state, o)
+	if result.Err == nil {
+		for _, x := range result.Value {
+			if x != nil {
+				f.auditRead(ctx, l, shard, "", x)
+			}
+		}
+	}
+	if o.vectors && result.Err == nil {
+		for i, idx := range batch.Index {
+			x, y := batch.Data[idx], result.Value[i]
+			if y == nil || y == x {
+				continue
+			}
+			y.BelongsToNode, y.BelongsToShard, y.IsConsistent = x.BelongsToNode, x.BelongsToShard, x.IsConsistent
+			batch.Data[idx] = y
+		}
 	}
-	result := <-f.readBatchPart(ctx, batch, ids, replyCh, state)
 	return result.Value, result.Err
 }
 
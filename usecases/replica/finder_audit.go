@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// AuditEventKind is the kind of operation recorded by an AuditEvent
+type AuditEventKind string
+
+const (
+	// AuditRead an object has been read
+	AuditRead AuditEventKind = "read"
+	// AuditRepair a replica has been overwritten with the most recent version of an object
+	AuditRepair AuditEventKind = "repair"
+	// AuditConflict a replica refused to be repaired because its version changed
+	AuditConflict AuditEventKind = "conflict"
+)
+
+// AuditEvent records a read or a repair made by the Finder
+type AuditEvent struct {
+	Kind AuditEventKind
+	Time time.Time
+	// User is the username of the principal found in the request context, if any
+	User  string
+	Class string
+	Shard string
+	ID    strfmt.UUID
+	Level ConsistencyLevel
+	// Node is the replica read from or repaired, empty if the read involved several replicas
+	Node string
+	// UpdateTime is the version read or written
+	UpdateTime int64
+	// StaleUpdateTime is the version replaced by a repair
+	StaleUpdateTime int64
+	Deleted         bool
+	Err             string
+	// Labels are the labels of the read, see ContextWithLabels
+	Labels map[string]string
+}
+
+// audit sends e to the audit channel, if any. It never blocks:
+// events are dropped while the channel is full.
+func (r *repairer) audit(ctx context.Context, e AuditEvent) {
+	if r.auditCh == nil {
+		return
+	}
+	e.Time = time.Now()
+	e.Class = r.class
+	if p, ok := ctx.Value("principal").(*models.Principal); ok && p != nil {
+		e.User = p.Username
+	}
+	e.Labels = labelsFrom(ctx)
+	select {
+	case r.auditCh <- e:
+	default:
+	}
+}
+
+// auditRead records that x has been read from shard at level l. node is
+// the replica which served the read, if the read involved a single one.
+func (r *repairer) auditRead(ctx context.Context, l ConsistencyLevel, shard, node string, x *storobj.Object) {
+	if r.auditCh == nil {
+		return
+	}
+	r.audit(ctx, AuditEvent{
+		Kind:       AuditRead,
+		Shard:      shard,
+		ID:         x.ID(),
+		Level:      l,
+		Node:       node,
+		UpdateTime: x.LastUpdateTimeUnix(),
+	})
+}
+
+// auditRepairs records the repair of node with xs, given the response or error returned by node
+func (r *repairer) auditRepairs(ctx context.Context,
+	st rState,
+	shard, node string,
+	xs []*objects.VObject,
+	resp []RepairResponse,
+	err error,
+) {
+	if r.auditCh == nil {
+		return
+	}
+	conflicts := make(map[string]string, len(resp))
+	for _, x := range resp {
+		if x.Err != "" {
+			conflicts[x.ID] = x.Err
+		}
+	}
+	for _, x := range xs {
+		e := AuditEvent{
+			Kind:            AuditRepair,
+			Shard:           shard,
+			ID:              x.ID,
+			Level:           st.CLevel,
+			Node:            node,
+			UpdateTime:      x.LastUpdateTimeUnixMilli,
+			StaleUpdateTime: x.StaleUpdateTime,
+			Deleted:         x.Deleted,
+		}
+		if err != nil {
+			e.Err = err.Error()
+		} else if msg, ok := conflicts[x.ID.String()]; ok {
+			e.Kind, e.Err = AuditConflict, msg
+		}
+		r.audit(ctx, e)
+	}
+}
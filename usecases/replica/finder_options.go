@@ -0,0 +1,947 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/interval"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// FinderOption configures optional behaviour of the Finder
+type FinderOption func(f *Finder)
+
+// WithOneVerificationRate makes the finder verify a fraction of reads made
+// with consistency level ONE. A verified read issues a background digest
+// to another replica and logs any mismatch; it does not affect the result.
+// rate is in the range [0, 1], 0 disables verification.
+func WithOneVerificationRate(rate float64) FinderOption {
+	return func(f *Finder) {
+		f.oneVerificationRate = rate
+	}
+}
+
+// WithAcceptNewerReplicas makes read repair consider a replica which rejected
+// an overwrite, because it already holds a newer version than the pushed one,
+// as repaired instead of failing the read.
+func WithAcceptNewerReplicas() FinderOption {
+	return func(f *Finder) {
+		f.acceptNewerTarget = true
+	}
+}
+
+// WithAuthoritativeTombstones makes read repair honor a replica which rejected an
+// overwrite because it deleted the object after the pushed version: the object is
+// considered deleted, as if the tombstone had been read, instead of failing the read
+// or returning a version which would otherwise be resurrected. The deletion is then
+// propagated to the replicas holding, or repaired to, the pushed version.
+// Only reads of single objects are concerned.
+func WithAuthoritativeTombstones() FinderOption {
+	return func(f *Finder) {
+		f.respectTombstones = true
+	}
+}
+
+// WithPartialRepair makes GetOne succeed even if some overwrites sent by read repair
+// fail, as long as enough replicas hold the most recent version afterwards to satisfy
+// the requested consistency level. Failed overwrites are logged. By default any failed
+// overwrite fails the read.
+func WithPartialRepair() FinderOption {
+	return func(f *Finder) {
+		f.partialRepair = true
+	}
+}
+
+// WithClockSkewTolerance makes read repair suspect a most recent version whose
+// update time is more than tolerance ahead of the next most recent one, as it may
+// have been written by a node with a skewed clock. Such a version is not used to
+// overwrite the other replicas, the read fails with errClockSkew instead and
+// onSkew, if not nil, is called with the suspect node and how far it is ahead.
+// Only reads of single objects are checked.
+func WithClockSkewTolerance(tolerance time.Duration, onSkew func(node string, ahead time.Duration)) FinderOption {
+	return func(f *Finder) {
+		f.skewTolerance = tolerance.Milliseconds()
+		f.onSkew = onSkew
+	}
+}
+
+// WithVectorDimensionCheck makes read repair compare the dimensions of the vectors held
+// by the replicas it reads full objects from. Vectors of different dimensions, e.g. left
+// by a model change, are not propagated: the object is not repaired and its read fails
+// with a dimension conflict, which requires operator attention. onConflict, if not nil,
+// is called with the object and the name of the vector, empty for the unnamed vector.
+func WithVectorDimensionCheck(onConflict func(shard string, id strfmt.UUID, vector string)) FinderOption {
+	return func(f *Finder) {
+		f.checkDimensions = true
+		f.onDimensionConflict = onConflict
+	}
+}
+
+// WithRepairMissingOnly restricts read repair to creating objects on replicas which
+// hold neither the object nor its tombstone. Replicas holding a stale version are
+// left untouched, so as not to mask deeper problems, and are logged instead.
+// Reads still return the most recent version.
+func WithRepairMissingOnly() FinderOption {
+	return func(f *Finder) {
+		f.missingOnly = true
+	}
+}
+
+// WithMaxRefetch caps the number of most recent objects fetched at once when repairing
+// a batch, e.g. by GetAll or CheckConsistency, to avoid memory spikes in case of high
+// divergence. Objects beyond the cap are fetched in subsequent rounds, one after the
+// other. A value <= 0 fetches all objects at once.
+func WithMaxRefetch(n int) FinderOption {
+	return func(f *Finder) {
+		f.maxRefetch = n
+	}
+}
+
+// WithFetchRetries makes read repair of a single object try up to n other
+// replicas holding the most recent version if fetching it from the first one
+// fails. By default the read fails as soon as this fetch fails.
+func WithFetchRetries(n int) FinderOption {
+	return func(f *Finder) {
+		f.fetchRetries = n
+	}
+}
+
+// WithChangeHook calls onChanged with the ids of objects whose direct read was
+// stale, i.e. whose most recent version, as returned by GetOne or used to repair
+// by CheckConsistency, differs from the version read from the direct replica.
+// It lets caches invalidate results derived from the stale version. Repairs which
+// only bring lagging peers of an up to date direct replica in sync are not reported.
+func WithChangeHook(onChanged func(shard string, ids []strfmt.UUID)) FinderOption {
+	return func(f *Finder) {
+		f.onChanged = onChanged
+	}
+}
+
+// WithSizeOrderedRepairs makes CheckConsistency split the overwrites repairing a
+// replica into requests holding at most maxRequestBytes of serialized objects, sent
+// one after the other and ordered by the size of the fetched objects, smallest first,
+// so that repairs of small objects are not held up behind the transfer of large ones.
+// An object larger than maxRequestBytes is sent in a request of its own.
+func WithSizeOrderedRepairs(maxRequestBytes int64) FinderOption {
+	return func(f *Finder) {
+		if maxRequestBytes > 0 {
+			f.maxRequestBytes = maxRequestBytes
+		}
+	}
+}
+
+// WithRecentReadPriority makes the Finder remember the ids of the last size objects
+// read by GetOne. When CheckConsistency repairs a replica, the overwrites of these
+// hot objects are sent first, and the others only once they are done, so that a
+// repair byte limit (see WithRepairByteLimit) is spent on the hot set first.
+func WithRecentReadPriority(size int) FinderOption {
+	return func(f *Finder) {
+		if size > 0 {
+			f.recent = newRecentReads(size)
+		}
+	}
+}
+
+// WithVectorNodes designates, per shard, a node preferred for reads returning vectors,
+// e.g. a replica running on vector-optimized hardware. GetOne requesting vectors reads
+// the object from the designated node, and CheckConsistency WithVectors re-fetches
+// vectors from it when it holds the most recent version. The designated node is not
+// preferred while degraded or unreachable. Reads without vectors are not affected.
+func WithVectorNodes(nodes map[string]string) FinderOption {
+	return func(f *Finder) {
+		f.vectorNodes = nodes
+	}
+}
+
+// WithRepairErrors makes CheckConsistency fail with a *RepairError if some objects
+// could not be made consistent, instead of only leaving them flagged as inconsistent.
+// The error lists every such object with its cause, and can be retrieved using errors.As.
+func WithRepairErrors() FinderOption {
+	return func(f *Finder) {
+		f.repairErrors = true
+	}
+}
+
+// WithHedgedReads makes GetOne at consistency level ONE also query the next
+// replica if no reply has arrived after delay. The first successful reply is
+// used and the pending requests are cancelled. Each hedged request is charged
+// against the retry budget, see WithRetryBudget. A delay <= 0 disables hedging.
+func WithHedgedReads(delay time.Duration) FinderOption {
+	return func(f *Finder) {
+		f.hedgeDelay = delay
+	}
+}
+
+// WithNodeHealth makes the Finder consult health when choosing the replicas to read
+// from: replicas of unhealthy nodes are moved to the end of the participants, so that
+// they are neither chosen for direct reads nor queried as long as enough healthy
+// replicas are available to meet the consistency level.
+func WithNodeHealth(health NodeHealth) FinderOption {
+	return func(f *Finder) {
+		f.health = health
+	}
+}
+
+// WithBackoff replaces the default exponential backoff between retries of a
+// replica which failed a read. newBackoff is called once per retried replica.
+// Retries still stop after the coordinator's maximum elapsed time.
+func WithBackoff(newBackoff func() interval.Backoff) FinderOption {
+	return func(f *Finder) {
+		f.newBackoff = newBackoff
+	}
+}
+
+// WithComparator replaces the update time comparison used by read repair to
+// decide which replica holds the freshest version of an object.
+// compare returns a positive number if a is fresher than b, a negative one if
+// b is fresher than a and 0 if both are equally fresh.
+func WithComparator(compare func(a, b RepairResponse) int) FinderOption {
+	return func(f *Finder) {
+		f.comparator = compare
+	}
+}
+
+// WithLocalReader lets reads at consistency level ONE be answered from the
+// replica held by this node, if any, without a network round trip.
+// Remote replicas are only queried if the object is missing locally.
+// GetOne reads locally only whole objects: reads restricted to some properties,
+// requesting vectors or made WithMetadataOnly are always sent to a replica.
+func WithLocalReader(local LocalReader) FinderOption {
+	return func(f *Finder) {
+		f.local = local
+	}
+}
+
+// WithNodeAffinity makes GetOne prefer, for each shard, the node which served
+// the last direct read of that shard, until a direct read from it fails.
+// Consecutive reads then hit the same replica, improving its cache locality.
+// This is a hint which does not affect consistency.
+func WithNodeAffinity() FinderOption {
+	return func(f *Finder) {
+		f.affinity = &sync.Map{}
+	}
+}
+
+// WithLatencyObserver makes the finder record the latency of every request
+// sent to a replica, per node and per operation (e.g. FetchObject, DigestObjects)
+func WithLatencyObserver(observer LatencyObserver) FinderOption {
+	return func(f *Finder) {
+		f.client.latency = observer
+	}
+}
+
+// WithRepairByteLimit caps the bytes of overwrites sent by read repair (and by
+// Overwrite) to the replicas of a shard to maxBytes per interval. Once the cap has
+// been reached, further repairs of the shard are deferred until the next interval:
+// reads still succeed, leaving the stale replicas to a later repair. onDeferred, if
+// not nil, is called with the shard and the size of each deferred overwrite.
+func WithRepairByteLimit(maxBytes int64, interval time.Duration, onDeferred func(shard string, bytes int64)) FinderOption {
+	return func(f *Finder) {
+		f.client.repairLimit = &repairLimiter{
+			maxBytes:   maxBytes,
+			interval:   interval,
+			onDeferred: onDeferred,
+			windows:    make(map[string]*repairWindow),
+		}
+	}
+}
+
+// WithAuditEvents makes the Finder send an AuditEvent on ch for each object
+// returned by GetOne or CheckConsistency and for each repaired object. Sending
+// never blocks reads: events are dropped while ch is full, so ch should be
+// buffered and drained continuously.
+func WithAuditEvents(ch chan<- AuditEvent) FinderOption {
+	return func(f *Finder) {
+		f.auditCh = ch
+	}
+}
+
+// WithStalestNodeHook tracks which replica was the stalest in each of the last window
+// reads with diverging replicas: the one furthest behind for GetOne, the one behind on
+// the most objects for CheckConsistency. onStalest is called with the node name once a
+// node has been the stalest in at least threshold (a fraction) of these reads, so that
+// cluster management can check its health. It is called again for the same node only
+// after its frequency has dropped below threshold. Reads with several equally stale
+// replicas are not counted.
+func WithStalestNodeHook(window int, threshold float64, onStalest func(node string, frequency float64)) FinderOption {
+	return func(f *Finder) {
+		if window <= 0 || onStalest == nil {
+			return
+		}
+		f.stalest = &stalestTracker{
+			window:    window,
+			threshold: threshold,
+			onStalest: onStalest,
+			counts:    make(map[string]int),
+			flagged:   make(map[string]struct{}),
+		}
+	}
+}
+
+// WithStalenessBreaker flags a replica as degraded once it lags more than maxLag
+// update time units (milliseconds) behind the freshest replica on at least maxObjects
+// objects of a single CheckConsistency call. onDegraded, which may be nil, is called
+// with the node name when a replica becomes degraded. Degraded replicas are no longer
+// chosen to serve direct reads, unless requested by the caller, until restored with
+// Finder.RestoreNode.
+func WithStalenessBreaker(maxLag int64, maxObjects int, onDegraded func(node string)) FinderOption {
+	return func(f *Finder) {
+		f.breaker = &stalenessBreaker{
+			maxLag:     maxLag,
+			maxObjects: maxObjects,
+			onDegraded: onDegraded,
+			degraded:   make(map[string]struct{}),
+		}
+	}
+}
+
+// ReadOption configures a single read made through the Finder
+type ReadOption func(o *readOptions)
+
+type readOptions struct {
+	// generation expected from replicas, 0 means any generation
+	generation uint64
+	// olderFallback allows repairing to an older version if the most recent one changed
+	olderFallback bool
+	// repairedToOlder is set if the read fell back to an older version
+	repairedToOlder *bool
+	// backgroundRepair serves the read at level ONE and reads at the requested level in the background
+	backgroundRepair bool
+	// onConfirmed receives the result of the background read, nil discards it
+	onConfirmed func(*storobj.Object, error)
+	// minAcks is the minimum number of replicas which must respond, 0 means the consistency level decides
+	minAcks int
+	// maxFailures caps the number of replicas which may fail, negative means the consistency level decides
+	maxFailures int
+	// trace records the decisions taken by the read, nil disables tracing
+	trace *ReadTrace
+	// digests receives the responses collected in digestSet, nil disables collection
+	digests   *map[string]RepairResponse
+	digestSet *replicaDigests
+	// maxRepairRounds caps the number of windows in which GetAll repairs replicas, 0 means no cap
+	maxRepairRounds int
+	// repairRound is set once replicas are repaired in the current window of GetAll
+	repairRound *atomic.Bool
+	// noRepair leaves stale replicas as they are, once maxRepairRounds is reached
+	noRepair bool
+	// repairIncomplete is set if repairs were skipped because of maxRepairRounds
+	repairIncomplete *bool
+	incomplete       *atomic.Bool // nil unless repairIncomplete is set
+	// retryBudget is the number of retries left to the read, nil means no limit
+	retryBudget *atomic.Int64
+	// maxFanOut caps the number of replicas queried per shard, 0 means no cap
+	maxFanOut int
+	// confirmSource requires a second replica to confirm fetched repair sources
+	confirmSource bool
+	// scopedRepair skips repairs if the stale version read directly only differs
+	// from the most recent one in properties which have not been requested
+	scopedRepair bool
+	// failures collects objects CheckConsistency could not repair, nil disables it
+	failures *repairFailures
+	// metadataOnly restricts the direct read of GetOne to the object's metadata
+	metadataOnly bool
+	// props and vector are the properties and vector requested by GetOne
+	props  search.SelectProperties
+	vector bool
+	// vectors requires objects returned by CheckConsistency to carry their vectors
+	vectors bool
+	// acks receives the nodes collected in ackSet, nil disables collection
+	acks   *[]string
+	ackSet *nodeSet // shared by concurrent shard reads
+	// winners receives the sources collected in winnerMap, nil disables collection
+	winners   *map[strfmt.UUID]string
+	winnerMap *idMap[string] // shared by concurrent shard reads
+	// staleness receives how far returned objects are behind the update times in freshest
+	staleness *map[strfmt.UUID]time.Duration
+	freshest  *idMap[int64] // shared by concurrent shard reads
+	// rpcStats receives the number of requests sent to replicas, nil disables counting
+	rpcStats *RPCStats
+	// existsMode controls whether Exists repairs diverging replicas
+	existsMode ExistsMode
+	// order is the order in which GetAll emits objects
+	order Order
+	// maxAge bounds how far GetOne at level ONE may lag behind a second replica, 0 disables it
+	maxAge time.Duration
+	// soleSurvivor accepts the direct read if all other replicas failed,
+	// soleRead is then set if the object was returned that way
+	soleSurvivor bool
+	soleRead     *atomic.Bool
+	// degradeToQuorum retries GetOne at Quorum if too few replicas replied at All
+	degradeToQuorum bool
+	// provenance receives the level achieved by GetOne and the nodes in ackSet, nil disables it
+	provenance *Provenance
+	// repairTargets restricts repairs to these nodes, nil means any stale node
+	repairTargets map[string]struct{}
+	// repairShare is the fraction of the remaining deadline awaited for repairs, 0 means all of it
+	repairShare float64
+	// localVersion is the update time of a version known to be written to
+	// the replica at localHost, 0 means unknown
+	localVersion int64
+	localHost    string
+}
+
+// detached returns a copy of o which does not report to the outputs of the read,
+// for work which may outlive it
+func (o readOptions) detached() readOptions {
+	o.trace = nil
+	o.repairedToOlder = nil
+	return o
+}
+
+// background returns a detached copy of o for the read at the requested level which
+// follows a read served by WithBackgroundRepair. None of the caller's outputs is reported to.
+func (o readOptions) background() readOptions {
+	o = o.detached()
+	o.backgroundRepair, o.onConfirmed = false, nil
+	o.acks, o.ackSet = nil, nil
+	o.winners, o.winnerMap = nil, nil
+	o.staleness, o.freshest = nil, nil
+	o.digests, o.digestSet = nil, nil
+	o.repairIncomplete, o.incomplete = nil, nil
+	o.rpcStats, o.provenance = nil, nil
+	if o.soleSurvivor {
+		o.soleRead = &atomic.Bool{}
+	}
+	return o
+}
+
+func newReadOptions(opts []ReadOption) readOptions {
+	o := readOptions{maxFailures: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithGeneration excludes replicas whose reported shard generation differs from gen.
+// Such replicas (e.g. a copy being rebuilt during shard movement) do not count toward
+// the consistency level. Replicas which do not report a generation are accepted.
+func WithGeneration(gen uint64) ReadOption {
+	return func(o *readOptions) {
+		o.generation = gen
+	}
+}
+
+// WithOlderFallback makes GetOne fall back to the next freshest version instead of
+// failing when the replica holding the most recent version changed during repair.
+// Replicas holding that version or a newer one are left untouched, older replicas
+// are repaired to the fallback version. If the read falls back, *repairedToOlder
+// is set to true; repairedToOlder may be nil.
+func WithOlderFallback(repairedToOlder *bool) ReadOption {
+	return func(o *readOptions) {
+		o.olderFallback = true
+		o.repairedToOlder = repairedToOlder
+	}
+}
+
+// WithBackgroundRepair makes GetOne return the object read from a single replica
+// (consistency level ONE) while a read at the requested level runs in the background,
+// repairing stale replicas for subsequent reads. Background failures are only logged.
+// The background read is made with the other options of the read, e.g. WithRepairTargets,
+// but does not report to its outputs such as WithAcks or WithTrace.
+func WithBackgroundRepair() ReadOption {
+	return func(o *readOptions) {
+		o.backgroundRepair = true
+	}
+}
+
+// WithConfirmation works like WithBackgroundRepair, but additionally passes the
+// result of the background read at the requested level to onConfirmed.
+// The object returned by GetOne is the first available (pre-repair) version, the
+// one passed to onConfirmed is the consistent, possibly repaired, version. Both may
+// differ. onConfirmed is called from another goroutine, and not at all if the
+// Finder has been closed.
+func WithConfirmation(onConfirmed func(obj *storobj.Object, err error)) ReadOption {
+	return func(o *readOptions) {
+		o.backgroundRepair = true
+		o.onConfirmed = onConfirmed
+	}
+}
+
+// WithMinAcks requires at least n replicas to respond, raising the number
+// dictated by the consistency level. It never lowers it. The read fails if
+// fewer than n replicas are available.
+func WithMinAcks(n int) ReadOption {
+	return func(o *readOptions) {
+		o.minAcks = n
+	}
+}
+
+// WithMaxFailures lets at most n replicas fail during the read, by requiring
+// replies from all other replicas. It can only lower the number of failures
+// tolerated by the consistency level (see Finder.ToleratedFailures), never raise it.
+func WithMaxFailures(n int) ReadOption {
+	return func(o *readOptions) {
+		if n >= 0 {
+			o.maxFailures = n
+		}
+	}
+}
+
+// WithMaxFanOut caps the number of replicas a read may query per shard at n,
+// fallbacks for failed replicas included, by sampling n of them. It is meant for
+// shards with a large replication factor. The cap never drops below the number of
+// replies required by the consistency level, but it leaves fewer replicas to fall
+// back to, so reads fail sooner when replicas fail. A value n <= 0 means no cap.
+func WithMaxFanOut(n int) ReadOption {
+	return func(o *readOptions) {
+		o.maxFanOut = n
+	}
+}
+
+// WithConfirmedRepairSource makes CheckConsistency confirm each most recent version
+// fetched from a single replica against a second replica holding the same version
+// before using it to repair the others, so that a corrupt copy is not spread to all
+// replicas. It costs one additional read per replica used as a source. Objects which
+// cannot be confirmed are not repaired and are reported as inconsistent.
+func WithConfirmedRepairSource() ReadOption {
+	return func(o *readOptions) {
+		o.confirmSource = true
+	}
+}
+
+// WithMaxRepairRounds limits GetAll to repairing replicas in at most n of its windows,
+// to avoid thrashing shards under heavy churn where different replicas hold the
+// freshest version of different objects. Once n windows have been repaired, the
+// following ones are returned as read: objects left stale on some replica are
+// reported as inconsistent and *repairIncomplete is set to true. repairIncomplete
+// may be nil. A value n <= 0 means no cap.
+func WithMaxRepairRounds(n int, repairIncomplete *bool) ReadOption {
+	return func(o *readOptions) {
+		o.maxRepairRounds = n
+		o.repairIncomplete = repairIncomplete
+		if repairIncomplete != nil {
+			o.incomplete = &atomic.Bool{}
+		}
+	}
+}
+
+// skippedRepair records that a repair was skipped because of maxRepairRounds
+func (o readOptions) skippedRepair() {
+	if o.incomplete != nil {
+		o.incomplete.Store(true)
+	}
+}
+
+// WithRetryBudget caps the number of retried replica requests issued by a
+// single read at n, across all shards, nodes and objects. Once the budget is spent,
+// replicas which fail are no longer retried and the read fails unless enough
+// replicas responded. This bounds the requests a misbehaving shard can cause.
+func WithRetryBudget(n int) ReadOption {
+	return func(o *readOptions) {
+		o.retryBudget = &atomic.Int64{}
+		o.retryBudget.Store(int64(n))
+	}
+}
+
+// WithPropertyScopedRepair makes GetOne skip repairs if the version read directly
+// is stale but only differs from the most recent version in properties the caller
+// did not request. The read is then served from the stale version and replicas are
+// left as they are. It has no effect if no properties are selected (all properties).
+func WithPropertyScopedRepair() ReadOption {
+	return func(o *readOptions) {
+		o.scopedRepair = true
+	}
+}
+
+// WithVectors makes CheckConsistency return objects together with their vectors,
+// sparing callers which re-rank results a second round trip. Input objects without
+// a vector are re-fetched from the replica holding the most recent version, and each
+// input object is replaced in place by the resolved one. Since repairs are built from
+// the resolved objects, repaired replicas retain their vectors as well.
+func WithVectors() ReadOption {
+	return func(o *readOptions) {
+		o.vectors = true
+	}
+}
+
+// WithAcks sets *acks to the sorted names of the nodes whose replies counted toward
+// satisfying the consistency level of a successful GetOne or CheckConsistency call.
+// Replicas which were repaired during the read count as acknowledging it, since they
+// hold the returned version afterwards. This lets callers audit which replicas backed
+// a result and pick those nodes for follow-up writes.
+func WithAcks(acks *[]string) ReadOption {
+	return func(o *readOptions) {
+		o.acks = acks
+		o.ackSet = &nodeSet{}
+	}
+}
+
+// WithWinners sets *winners to a map from the id of each object checked by
+// CheckConsistency to the name of the node which provided its most recent version.
+// Objects agreed upon by all replicas are attributed to the node of the direct read.
+// It lets callers audit repairs and rank which nodes are most often authoritative.
+func WithWinners(winners *map[strfmt.UUID]string) ReadOption {
+	return func(o *readOptions) {
+		o.winners = winners
+		o.winnerMap = &idMap[string]{}
+	}
+}
+
+// WithStaleness sets *staleness to a map from the id of each object returned by GetOne,
+// or checked by CheckConsistency, to how far its update time is behind the most recent
+// version seen by the read. It is 0 unless the returned version has not been brought up
+// to date, e.g. because the repair was skipped or failed. It lets callers report the
+// freshness of reads. Reads served by a single replica always report 0.
+func WithStaleness(staleness *map[strfmt.UUID]time.Duration) ReadOption {
+	return func(o *readOptions) {
+		o.staleness = staleness
+		o.freshest = &idMap[int64]{}
+	}
+}
+
+// stalenessOf returns how far each of xs is behind the most recent version seen by the read
+func (o readOptions) stalenessOf(xs ...*storobj.Object) map[strfmt.UUID]time.Duration {
+	m := make(map[strfmt.UUID]time.Duration, len(xs))
+	for _, x := range xs {
+		if x == nil {
+			continue
+		}
+		var d time.Duration
+		if t, ok := o.freshest.get(x.ID()); ok && t > x.LastUpdateTimeUnix() {
+			d = time.Duration(t-x.LastUpdateTimeUnix()) * time.Millisecond
+		}
+		m[x.ID()] = d
+	}
+	return m
+}
+
+// ExistsMode controls how Exists resolves replicas reporting different versions
+type ExistsMode int
+
+const (
+	// ExistsRepair fetches the most recent version and overwrites stale replicas
+	// with it before reporting its existence. It is the default.
+	ExistsRepair ExistsMode = iota
+	// ExistsDigestOnly determines existence from the most recent digest alone,
+	// without fetching the object or repairing stale replicas
+	ExistsDigestOnly
+)
+
+// WithExistsMode sets how Exists, ExistsOrGet and ExistsVerified resolve replicas
+// reporting different versions. ExistsDigestOnly trades the convergence of replicas
+// for speed and makes the check free of side effects.
+func WithExistsMode(mode ExistsMode) ReadOption {
+	return func(o *readOptions) {
+		o.existsMode = mode
+	}
+}
+
+// Order is the order in which GetAll emits the objects of a window
+type Order int
+
+const (
+	// OrderInput keeps the order of the requested ids. It is the default.
+	OrderInput Order = iota
+	// OrderUpdateTimeDesc emits the most recently updated objects first
+	OrderUpdateTimeDesc
+	// OrderIDAsc emits objects sorted by id
+	OrderIDAsc
+)
+
+// WithOrder sets the order in which GetAll emits the objects of each window, e.g.
+// the freshest first for display. The order holds across all objects only if they
+// are read in a single window. Objects with equal keys keep their input order.
+func WithOrder(order Order) ReadOption {
+	return func(o *readOptions) {
+		o.order = order
+	}
+}
+
+// sort sorts xs in the given order, objects with equal keys keeping their order
+func (order Order) sort(xs []*storobj.Object) {
+	switch order {
+	case OrderUpdateTimeDesc:
+		sort.SliceStable(xs, func(i, j int) bool {
+			return xs[i].LastUpdateTimeUnix() > xs[j].LastUpdateTimeUnix()
+		})
+	case OrderIDAsc:
+		sort.SliceStable(xs, func(i, j int) bool { return xs[i].ID() < xs[j].ID() })
+	}
+}
+
+// WithMaxAge bounds the staleness of GetOne at level ONE. The update time of the object
+// read is compared with the digest of a second replica, and if that replica holds a
+// version more than maxAge more recent, the object is read from it and returned instead.
+// It costs one digest read per GetOne, and one more read when the first replica lags.
+// The bound is best effort: if the second replica fails, the object read is returned.
+func WithMaxAge(maxAge time.Duration) ReadOption {
+	return func(o *readOptions) {
+		o.maxAge = maxAge
+	}
+}
+
+// WithSoleSurvivor makes GetOne at Quorum or All return the object of the direct read
+// if it succeeded but every other replica failed, instead of failing the consistency
+// level. This trades consistency for availability in a degraded cluster. A warning is
+// logged for each such read. The IsConsistent flag of the returned object is then set
+// by GetOne: it is false if the object was returned by the sole surviving replica.
+func WithSoleSurvivor() ReadOption {
+	return func(o *readOptions) {
+		o.soleSurvivor = true
+		o.soleRead = &atomic.Bool{}
+	}
+}
+
+// WithDegradeToQuorum makes GetOne at level All retry the read at Quorum if too few
+// replicas replied to satisfy All, instead of failing. A warning is logged for each
+// degraded read. WithProvenance reports the level the result was served at.
+func WithDegradeToQuorum() ReadOption {
+	return func(o *readOptions) {
+		o.degradeToQuorum = true
+	}
+}
+
+// withoutDegrade disables WithDegradeToQuorum, it is used for the attempt at level All
+func withoutDegrade() ReadOption {
+	return func(o *readOptions) {
+		o.degradeToQuorum = false
+	}
+}
+
+// withOptions replaces all options by o, it is used to repeat a read with the options it was made with
+func withOptions(o readOptions) ReadOption {
+	return func(x *readOptions) {
+		*x = o
+	}
+}
+
+// Provenance describes how the result of a read was served
+type Provenance struct {
+	// Level is the consistency level achieved, it is below the requested
+	// level if the read degraded, e.g. with WithDegradeToQuorum or WithSoleSurvivor
+	Level ConsistencyLevel
+	// Nodes are the sorted names of the nodes whose replies counted toward Level
+	Nodes []string
+}
+
+// WithProvenance sets *p to the consistency level achieved by a successful GetOne
+// and the nodes which acknowledged it. Reads served at the requested level report
+// that level, degraded reads the level they were actually served at.
+func WithProvenance(p *Provenance) ReadOption {
+	return func(o *readOptions) {
+		o.provenance = p
+		if o.ackSet == nil {
+			o.ackSet = &nodeSet{}
+		}
+	}
+}
+
+// WithRPCStats sets *stats to the number of requests of each kind sent to replicas
+// by GetOne, CheckConsistency or Exists, including the ones sent to repair stale
+// replicas. It makes the read and repair amplification of a read pattern visible.
+// Repairs completing in the background are not counted.
+func WithRPCStats(stats *RPCStats) ReadOption {
+	return func(o *readOptions) {
+		o.rpcStats = stats
+	}
+}
+
+// countRPCs returns a copy of ctx counting the requests sent with it if RPC stats
+// are requested. done must be called once the read has completed to set the stats.
+func (o readOptions) countRPCs(ctx context.Context) (_ context.Context, done func()) {
+	if o.rpcStats == nil {
+		return ctx, func() {}
+	}
+	ctx, c := withRPCCounter(ctx)
+	return ctx, func() { *o.rpcStats = c.stats() }
+}
+
+// WithRepairTargets restricts read repair to the given nodes, e.g. a node known
+// to be stale from external monitoring. The freshest version is still determined
+// from all replicas required by the consistency level, but overwrites are only
+// pushed to the target nodes. Other stale replicas are left as they are; objects
+// they hold stale are reported as inconsistent by CheckConsistency.
+func WithRepairTargets(nodes ...string) ReadOption {
+	return func(o *readOptions) {
+		o.repairTargets = make(map[string]struct{}, len(nodes))
+		for _, node := range nodes {
+			o.repairTargets[node] = struct{}{}
+		}
+	}
+}
+
+// skipRepair returns true if the replica at host must not be repaired
+func (o readOptions) skipRepair(st rState, host string) bool {
+	if o.repairTargets == nil {
+		return false
+	}
+	_, ok := o.repairTargets[st.nodeName(host)]
+	return !ok
+}
+
+// WithRepairDeadlineShare caps the time GetOne waits for read repair to the given
+// fraction (0, 1) of the deadline remaining once replicas have been read, so that a
+// slow overwrite does not consume the whole budget of the read. If the direct read
+// returned the most recent version and repair exceeds its share, that version is
+// returned and the repair completes in the background; failures are then only logged.
+// The option has no effect if the context has no deadline.
+func WithRepairDeadlineShare(fraction float64) ReadOption {
+	return func(o *readOptions) {
+		if fraction > 0 && fraction < 1 {
+			o.repairShare = fraction
+		}
+	}
+}
+
+// metadataOnly is the projection of a direct read made with WithMetadataOnly
+var metadataOnly = additional.Properties{NoProps: true, CreationTimeUnix: true, LastUpdateTimeUnix: true}
+
+// WithMetadataOnly makes GetOne fetch only the metadata of the object (id, class,
+// creation and update times) from the freshest replica, skipping its vector and
+// properties. It is lighter than a full read but, unlike Exists, returns the object.
+// The requested properties and additional properties are ignored.
+// Read repair still fetches the full object to repair stale replicas.
+func WithMetadataOnly() ReadOption {
+	return func(o *readOptions) {
+		o.metadataOnly = true
+	}
+}
+
+// WithLocalVersion tells GetOne that the replica held by this node has been written
+// with an object version whose update time is updateTime, e.g. by the write which just
+// preceded the read. Read repair never overwrites that replica with a version older than
+// updateTime, even if the replica does not report it yet. This prevents repairing a
+// just-written object backward.
+func WithLocalVersion(updateTime int64) ReadOption {
+	return func(o *readOptions) {
+		o.localVersion = updateTime
+	}
+}
+
+// keepsLocal returns true if host is the local replica and a version updated at
+// uTime would be older than the version known to be written to it
+func (o readOptions) keepsLocal(host string, uTime int64) bool {
+	return o.localVersion > 0 && host == o.localHost && uTime < o.localVersion
+}
+
+// nodeSet is a set of node names safe for concurrent use.
+// A nil set ignores additions.
+type nodeSet struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}
+
+func (s *nodeSet) add(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.names == nil {
+		s.names = make(map[string]struct{})
+	}
+	s.names[name] = struct{}{}
+}
+
+// sorted returns the names in the set in ascending order
+func (s *nodeSet) sorted() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	xs := make([]string, 0, len(s.names))
+	for name := range s.names {
+		xs = append(xs, name)
+	}
+	sort.Strings(xs)
+	return xs
+}
+
+// idMap maps object ids to values, it is safe for concurrent use
+type idMap[V any] struct {
+	mu sync.Mutex
+	m  map[strfmt.UUID]V
+}
+
+// set is a no-op on a nil receiver
+func (s *idMap[V]) set(id strfmt.UUID, v V) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = make(map[strfmt.UUID]V)
+	}
+	s.m[id] = v
+}
+
+func (s *idMap[V]) get(id strfmt.UUID) (v V, ok bool) {
+	if s == nil {
+		return v, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok = s.m[id]
+	return v, ok
+}
+
+// copy returns a copy of the map
+func (s *idMap[V]) copy() map[strfmt.UUID]V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := make(map[strfmt.UUID]V, len(s.m))
+	for id, v := range s.m {
+		m[id] = v
+	}
+	return m
+}
+
+// checkGeneration returns an error if one of the digests xs received from host
+// has been produced by an unexpected shard generation
+func (o readOptions) checkGeneration(host string, xs []RepairResponse) error {
+	for _, x := range xs {
+		if err := o.checkGenerationOf(host, x.Generation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkGenerationOf returns an error if gen, reported by host, is not the expected generation
+func (o readOptions) checkGenerationOf(host string, gen uint64) error {
+	if o.generation != 0 && gen != 0 && gen != o.generation {
+		return fmt.Errorf("%q: %w: expected %d got %d", host, errGeneration, o.generation, gen)
+	}
+	return nil
+}
+
+// sameRequestedContent returns true if a and b hold the same values for the
+// requested properties and vector. It is used by WithPropertyScopedRepair.
+func (o readOptions) sameRequestedContent(a, b objects.Replica) bool {
+	if len(o.props) == 0 || a.Deleted || b.Deleted || a.Object == nil || b.Object == nil {
+		return false
+	}
+	x, _ := a.Object.Object.Properties.(map[string]interface{})
+	y, _ := b.Object.Object.Properties.(map[string]interface{})
+	for _, p := range o.props {
+		if !reflect.DeepEqual(x[p.Name], y[p.Name]) {
+			return false
+		}
+	}
+	if o.vector {
+		return reflect.DeepEqual(a.Object.Vector, b.Object.Vector) &&
+			reflect.DeepEqual(a.Object.Vectors, b.Object.Vectors) &&
+			reflect.DeepEqual(a.Object.MultiVectors, b.Object.MultiVectors)
+	}
+	return true
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -29,6 +30,8 @@ import (
 type finderStream struct {
 	repairer
 	log logrus.FieldLogger
+	// background runs work which may outlive a read, see Finder.goBackground
+	background func(timeout time.Duration, fn func(ctx context.Context))
 }
 
 type (
@@ -45,12 +48,23 @@ type (
 	objResult = _Result[*storobj.Object]
 )
 
+// digest returns the digest of the object held by the sender
+func (t objTuple) digest(id strfmt.UUID) RepairResponse {
+	return RepairResponse{ID: id.String(), UpdateTime: t.UTime, Deleted: t.o.Deleted}
+}
+
+// missing returns true if the sender holds neither the object nor its tombstone
+func (t objTuple) missing() bool {
+	return t.UTime == 0 && !t.o.Deleted
+}
+
 // readOne reads one replicated object
 func (f *finderStream) readOne(ctx context.Context,
 	shard string,
 	id strfmt.UUID,
 	ch <-chan _Result[findOneReply],
 	st rState,
+	o readOptions,
 ) <-chan objResult {
 	// counters tracks the number of votes for each participant
 	resultCh := make(chan objResult, 1)
@@ -59,21 +73,27 @@ func (f *finderStream) readOne(ctx context.Context,
 		var (
 			votes      = make([]objTuple, 0, st.Level)
 			contentIdx = -1
+			failed     = false
 		)
 
 		for r := range ch { // len(ch) == st.Level
 			resp := r.Value
 			if r.Err != nil { // a least one node is not responding
-				f.log.WithField("op", "get").WithField("replica", resp.sender).
+				labeled(ctx, f.log).WithField("op", "get").WithField("replica", resp.sender).
 					WithField("class", f.class).WithField("shard", shard).
 					WithField("uuid", id).Error(r.Err)
-				resultCh <- objResult{nil, errRead}
-				return
+				if !o.soleSurvivor {
+					resultCh <- objResult{nil, st.readError()}
+					return
+				}
+				failed = true
+				continue
 			}
 			if !resp.DigestRead {
 				contentIdx = len(votes)
 			}
 			votes = append(votes, objTuple{resp.sender, resp.UpdateTime, resp.Data, 0, nil})
+			o.trace.addReplica(ReplicaTrace{resp.sender, resp.UpdateTime, resp.Data.Deleted, resp.DigestRead})
 
 			for i := range votes {
 				if votes[i].UTime != resp.UpdateTime {
@@ -88,6 +108,14 @@ func (f *finderStream) readOne(ctx context.Context,
 					continue
 				}
 
+				o.trace.setWinner(votes[i].sender, votes[i].UTime)
+				if votes[i].o.Deleted || i == contentIdx {
+					for _, x := range votes {
+						if x.UTime == votes[i].UTime {
+							o.ackSet.add(st.nodeName(x.sender))
+						}
+					}
+				}
 				if votes[i].o.Deleted {
 					resultCh <- objResult{nil, nil}
 					return
@@ -100,8 +128,35 @@ func (f *finderStream) readOne(ctx context.Context,
 			}
 		}
 
-		obj, err := f.repairOne(ctx, shard, id, votes, st, contentIdx)
+		if failed {
+			// only the direct read may be accepted, if every other replica failed
+			if len(votes) != 1 || contentIdx != 0 || votes[0].o.Object == nil || votes[0].o.Deleted {
+				resultCh <- objResult{nil, st.readError()}
+				return
+			}
+			labeled(ctx, f.log).WithField("op", "get").WithField("replica", votes[0].sender).
+				WithField("class", f.class).WithField("shard", shard).WithField("uuid", id).
+				Warn("returning object of the sole surviving replica, consistency level not met")
+			o.soleRead.Store(true)
+			o.ackSet.add(st.nodeName(votes[0].sender))
+			resultCh <- objResult{votes[0].o.Object, nil}
+			return
+		}
+
+		obj, err := f.repairWithin(ctx, shard, id, votes, st, contentIdx, o)
+		if err == nil && contentIdx >= 0 {
+			direct := votes[contentIdx]
+			if obj == nil && direct.o.Object != nil && !direct.o.Deleted ||
+				obj != nil && (direct.o.Object == nil || direct.o.Deleted || obj.LastUpdateTimeUnix() != direct.UTime) {
+				f.notifyChanged(shard, id)
+			}
+		}
 		if err == nil {
+			for _, x := range votes {
+				if !o.skipRepair(st, x.sender) || obj != nil && x.UTime == obj.LastUpdateTimeUnix() {
+					o.ackSet.add(st.nodeName(x.sender))
+				}
+			}
 			resultCh <- objResult{obj, nil}
 			return
 		}
@@ -114,7 +169,7 @@ func (f *finderStream) readOne(ctx context.Context,
 			}
 			fmt.Fprintf(&sb, "%s:%d", c.sender, c.UTime)
 		}
-		f.log.WithField("op", "repair_one").WithField("class", f.class).
+		labeled(ctx, f.log).WithField("op", "repair_one").WithField("class", f.class).
 			WithField("shard", shard).WithField("uuid", id).
 			WithField("msg", sb.String()).Error(err)
 	}
@@ -122,6 +177,67 @@ func (f *finderStream) readOne(ctx context.Context,
 	return resultCh
 }
 
+// repairWithin repairs a single object like repairOne. If o.repairShare is set and
+// the direct read returned the most recent version, the repair is only awaited for
+// its share of the remaining deadline. Past it the most recent version is returned
+// while the repair completes as background work of the finder. Since the repair may
+// outlive the read, it does not report to the outputs of o, e.g. its trace.
+func (f *finderStream) repairWithin(ctx context.Context,
+	shard string,
+	id strfmt.UUID,
+	votes []objTuple, st rState,
+	contentIdx int,
+	o readOptions,
+) (*storobj.Object, error) {
+	deadline, ok := ctx.Deadline()
+	if o.repairShare <= 0 || !ok || !f.holdsFreshest(id, votes, contentIdx) {
+		return f.repairOne(ctx, shard, id, votes, st, contentIdx, o)
+	}
+	share := time.Duration(float64(time.Until(deadline)) * o.repairShare)
+	var (
+		labels    = labelsFrom(ctx)
+		detached  = o.detached()
+		resultCh  = make(chan error)
+		abandoned = make(chan struct{}) // closed once the read stops waiting
+	)
+	f.background(20*time.Second, func(ctx context.Context) {
+		ctx = ContextWithLabels(ctx, labels)
+		_, err := f.repairOne(ctx, shard, id, votes, st, contentIdx, detached)
+		select {
+		case resultCh <- err:
+		case <-abandoned:
+			if err != nil {
+				labeled(ctx, f.log).WithField("op", "deferred_repair").WithField("class", f.class).
+					WithField("shard", shard).WithField("uuid", id).Error(err)
+			}
+		}
+	})
+
+	timer := time.NewTimer(share)
+	defer timer.Stop()
+	select {
+	case err := <-resultCh:
+		return votes[contentIdx].o.Object, err
+	case <-timer.C:
+		close(abandoned)
+	}
+	return votes[contentIdx].o.Object, nil
+}
+
+// holdsFreshest returns true if the direct read at contentIdx returned an
+// existing object at least as fresh as all other votes, none of which is deleted
+func (f *finderStream) holdsFreshest(id strfmt.UUID, votes []objTuple, contentIdx int) bool {
+	if contentIdx < 0 || votes[contentIdx].o.Object == nil {
+		return false
+	}
+	for _, x := range votes {
+		if x.o.Deleted || f.compare(x.digest(id), votes[contentIdx].digest(id)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 type (
 	batchResult _Result[[]*storobj.Object]
 
@@ -133,7 +249,25 @@ type (
 	}
 )
 
-type boolTuple tuple[RepairResponse]
+type (
+	boolTuple tuple[RepairResponse]
+
+	// existResult is the outcome of an existence check
+	existResult struct {
+		Exists bool
+		// Sender holds the agreed version, or the most recent one with ExistsDigestOnly.
+		// It is empty if the object has been repaired.
+		Sender     string
+		UpdateTime int64
+		// Object is the most recent object fetched during repair, if any
+		Object *storobj.Object
+	}
+)
+
+// missing returns true if the sender holds neither the object nor its tombstone
+func (t boolTuple) missing() bool {
+	return t.UTime == 0 && !t.o.Deleted
+}
 
 // readExistence checks if replicated object exists
 func (f *finderStream) readExistence(ctx context.Context,
@@ -141,8 +275,9 @@ func (f *finderStream) readExistence(ctx context.Context,
 	id strfmt.UUID,
 	ch <-chan _Result[existReply],
 	st rState,
-) <-chan _Result[bool] {
-	resultCh := make(chan _Result[bool], 1)
+	o readOptions,
+) <-chan _Result[existResult] {
+	resultCh := make(chan _Result[existResult], 1)
 	g := func() {
 		defer close(resultCh)
 		votes := make([]boolTuple, 0, st.Level) // number of votes per replica
@@ -150,10 +285,10 @@ func (f *finderStream) readExistence(ctx context.Context,
 		for r := range ch { // len(ch) == st.Level
 			resp := r.Value
 			if r.Err != nil { // at least one node is not responding
-				f.log.WithField("op", "exists").WithField("replica", resp.Sender).
+				labeled(ctx, f.log).WithField("op", "exists").WithField("replica", resp.Sender).
 					WithField("class", f.class).WithField("shard", shard).
 					WithField("uuid", id).Error(r.Err)
-				resultCh <- _Result[bool]{false, errRead}
+				resultCh <- _Result[existResult]{existResult{}, st.readError()}
 				return
 			}
 
@@ -173,17 +308,22 @@ func (f *finderStream) readExistence(ctx context.Context,
 				}
 
 				exists := !votes[i].o.Deleted && votes[i].o.UpdateTime != 0
-				resultCh <- _Result[bool]{exists, nil}
+				resultCh <- _Result[existResult]{existResult{exists, votes[i].sender, votes[i].UTime, nil}, nil}
 				return
 			}
 		}
 
-		obj, err := f.repairExist(ctx, shard, id, votes, st)
+		if o.existsMode == ExistsDigestOnly {
+			resultCh <- _Result[existResult]{f.freshestExist(votes), nil}
+			return
+		}
+
+		exists, obj, err := f.repairExist(ctx, shard, id, votes, st)
 		if err == nil {
-			resultCh <- _Result[bool]{obj, nil}
+			resultCh <- _Result[existResult]{existResult{Exists: exists, Object: obj}, nil}
 			return
 		}
-		resultCh <- _Result[bool]{false, errors.Wrap(err, errRepair.Error())}
+		resultCh <- _Result[existResult]{existResult{}, errors.Wrap(err, errRepair.Error())}
 
 		var sb strings.Builder
 		for i, c := range votes {
@@ -192,7 +332,7 @@ func (f *finderStream) readExistence(ctx context.Context,
 			}
 			fmt.Fprintf(&sb, "%s:%d", c.sender, c.UTime)
 		}
-		f.log.WithField("op", "repair_exist").WithField("class", f.class).
+		labeled(ctx, f.log).WithField("op", "repair_exist").WithField("class", f.class).
 			WithField("shard", shard).WithField("uuid", id).
 			WithField("msg", sb.String()).Error(err)
 	}
@@ -200,12 +340,23 @@ func (f *finderStream) readExistence(ctx context.Context,
 	return resultCh
 }
 
+// allHaveVectors returns true if each object in xs carries a vector
+func allHaveVectors(xs []objects.Replica) bool {
+	for _, x := range xs {
+		if !x.Deleted && !hasVectors(x.Object) {
+			return false
+		}
+	}
+	return true
+}
+
 // readBatchPart reads in replicated objects specified by their ids
 // It checks each object x for consistency and sets x.IsConsistent
 func (f *finderStream) readBatchPart(ctx context.Context,
 	batch shardPart,
 	ids []strfmt.UUID,
 	ch <-chan _Result[batchReply], st rState,
+	o readOptions,
 ) <-chan batchResult {
 	resultCh := make(chan batchResult, 1)
 
@@ -221,9 +372,16 @@ func (f *finderStream) readBatchPart(ctx context.Context,
 		for r := range ch { // len(ch) == st.Level
 			resp := r.Value
 			if r.Err != nil { // at least one node is not responding
-				f.log.WithField("op", "read_batch.get").WithField("replica", r.Value.Sender).
+				labeled(ctx, f.log).WithField("op", "read_batch.get").WithField("replica", r.Value.Sender).
 					WithField("class", f.class).WithField("shard", batch.Shard).Error(r.Err)
-				resultCh <- batchResult{nil, errRead}
+				resultCh <- batchResult{nil, st.readError()}
+				return
+			}
+			resp, err := resp.aligned(ids)
+			if err != nil {
+				labeled(ctx, f.log).WithField("op", "read_batch.get").WithField("replica", resp.Sender).
+					WithField("class", f.class).WithField("shard", batch.Shard).Error(err)
+				resultCh <- batchResult{nil, st.readError()}
 				return
 			}
 			if !resp.IsDigest {
@@ -251,21 +409,30 @@ func (f *finderStream) readBatchPart(ctx context.Context,
 				}
 			}
 
-			if M == N { // all objects are consistent
+			if M == N && !(o.vectors && !allHaveVectors(votes[contentIdx].FullData)) { // all objects are consistent
 				for _, idx := range batch.Index {
 					batch.Data[idx].IsConsistent = true
 				}
+				for _, x := range votes {
+					o.ackSet.add(st.nodeName(x.Sender))
+				}
+				for _, id := range ids {
+					o.winnerMap.set(id, st.nodeName(votes[contentIdx].Sender))
+				}
 				resultCh <- batchResult{fromReplicas(votes[contentIdx].FullData), nil}
 				return
 			}
 		}
-		res, err := f.repairBatchPart(ctx, batch.Shard, ids, votes, st, contentIdx)
+		res, err := f.repairBatchPart(ctx, batch.Shard, ids, votes, st, contentIdx, o)
 		if err != nil {
 			resultCh <- batchResult{nil, errRepair}
-			f.log.WithField("op", "repair_batch").WithField("class", f.class).
+			labeled(ctx, f.log).WithField("op", "repair_batch").WithField("class", f.class).
 				WithField("shard", batch.Shard).WithField("uuids", ids).Error(err)
 			return
 		}
+		for _, x := range votes {
+			o.ackSet.add(st.nodeName(x.Sender))
+		}
 		// count total number of votes
 		maxCount := len(votes) * len(votes)
 		sum := votes[0].Count
@@ -276,7 +443,9 @@ func (f *finderStream) readBatchPart(ctx context.Context,
 		}
 		// set consistency flag
 		for i, n := range sum {
-			if n == maxCount { // if consistent
+			if n != maxCount {
+				o.failures.add(ids[i], errInconsistent)
+			} else { // if consistent
 				x := res[i]
 
 				if x == nil {
@@ -313,6 +482,41 @@ type batchReply struct {
 	DigestData []RepairResponse
 }
 
+// missingAt returns true if the sender holds neither the object at idx nor its tombstone
+func (r batchReply) missingAt(idx int) bool {
+	if len(r.DigestData) != 0 {
+		return r.DigestData[idx].UpdateTime == 0 && !r.DigestData[idx].Deleted
+	}
+	return r.FullData[idx].UpdateTime() == 0 && !r.FullData[idx].Deleted
+}
+
+// aligned returns r holding exactly one entry per id. An empty reply means that
+// none of the objects exists on the sender: it is filled with absent entries, so
+// that reading ids absent from every replica succeeds with nil objects.
+// Other replies whose length does not match ids are rejected.
+func (r batchReply) aligned(ids []strfmt.UUID) (batchReply, error) {
+	n := len(r.FullData)
+	if r.IsDigest {
+		n = len(r.DigestData)
+	}
+	switch {
+	case n == len(ids):
+	case n == 0 && r.IsDigest:
+		r.DigestData = make([]RepairResponse, len(ids))
+		for i, id := range ids {
+			r.DigestData[i].ID = id.String()
+		}
+	case n == 0:
+		r.FullData = make([]objects.Replica, len(ids))
+		for i, id := range ids {
+			r.FullData[i].ID = id
+		}
+	default:
+		return r, fmt.Errorf("%s returned %d results for %d objects", r.Sender, n, len(ids))
+	}
+	return r, nil
+}
+
 // UpdateTimeAt gets update time from reply
 func (r batchReply) UpdateTimeAt(idx int) int64 {
 	if len(r.DigestData) != 0 {
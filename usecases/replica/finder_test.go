@@ -13,12 +13,18 @@ package replica
 
 import (
 	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/interval"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -88,36 +94,865 @@ func TestFinderReplicaNotFound(t *testing.T) {
 
 	finder.CheckConsistency(ctx, All, []*storobj.Object{objectEx("1", 1, "S", "N")})
 	f.assertLogErrorContains(t, errReplicas.Error())
+
+	t.Run("KnownShardWithoutReplicas", func(t *testing.T) {
+		_, err := finder.GetOne(ctx, One, "S", "id", nil, additional.Properties{})
+		assert.ErrorIs(t, err, errReplicas)
+		assert.ErrorIs(t, err, errNoLiveReplicas)
+		assert.NotErrorIs(t, err, errUnknownShard)
+
+		_, err = finder.Exists(ctx, One, "S", "id")
+		assert.ErrorIs(t, err, errReplicas)
+		assert.ErrorIs(t, err, errNoLiveReplicas)
+	})
+
+	t.Run("UnknownShard", func(t *testing.T) {
+		_, err := finder.GetOne(ctx, One, "unknown", "id", nil, additional.Properties{})
+		assert.ErrorIs(t, err, errReplicas)
+		assert.ErrorIs(t, err, errUnknownShard)
+		assert.NotErrorIs(t, err, errNoLiveReplicas)
+
+		_, err = finder.Exists(ctx, One, "unknown", "id")
+		assert.ErrorIs(t, err, errReplicas)
+		assert.ErrorIs(t, err, errUnknownShard)
+	})
+}
+
+func TestFinderNodeObject(t *testing.T) {
+	var (
+		id    = strfmt.UUID("123")
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		r     = objects.Replica{ID: id, Object: object(id, 3)}
+		adds  = additional.Properties{}
+		proj  = search.SelectProperties{}
+	)
+
+	t.Run("Unresolved", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		_, err := finder.NodeObject(ctx, "N", "S", "id", nil, additional.Properties{})
+		assert.Contains(t, err.Error(), "N")
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(r, nil)
+		}
+		got, err := finder.NodeObject(ctx, nodes[0], shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, r.Object, got)
+	})
+}
+
+func TestFinderNodeObjectAny(t *testing.T) {
+	var (
+		id    = strfmt.UUID("123")
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		r     = objects.Replica{ID: id, Object: object(id, 3)}
+		adds  = additional.Properties{}
+		proj  = search.SelectProperties{}
+	)
+
+	t.Run("FastestWins", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		cancelled := make(chan string, 2)
+		for _, n := range nodes[:2] {
+			n := n
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Run(func(args mock.Arguments) {
+				<-args.Get(0).(context.Context).Done()
+				cancelled <- n
+			}).Return(objects.Replica{}, context.Canceled)
+		}
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(r, nil)
+
+		got, err := finder.NodeObjectAny(ctx, nodes, shard, id, proj, adds)
+		require.Nil(t, err)
+		assert.Equal(t, r.Object, got)
+		for range nodes[:2] {
+			select {
+			case <-cancelled:
+			case <-time.After(5 * time.Second):
+				t.Fatal("slower reads have not been cancelled")
+			}
+		}
+	})
+
+	t.Run("AllFail", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes[:2] {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(objects.Replica{}, errAny)
+		}
+		_, err := finder.NodeObjectAny(ctx, []string{"A", "B", "N"}, shard, id, proj, adds)
+		require.NotNil(t, err)
+		for _, n := range []string{"A:", "B:", "N:", errAny.Error()} {
+			assert.Contains(t, err.Error(), n)
+		}
+	})
+}
+
+func TestFinderObjectVersions(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		digestIDs = []strfmt.UUID{id}
+		digestR0  = []RepairResponse{{ID: id.String(), UpdateTime: 0, Deleted: true}}
+		digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("Divergent", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR0, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+
+		got, err := finder.ObjectVersions(ctx, shard, id)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]int64{"A": 0, "B": 2, "C": 3}, got)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("NodeFailure", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+
+		_, err := finder.ObjectVersions(ctx, shard, id)
+		assert.ErrorIs(t, err, errAny)
+		assert.ErrorContains(t, err, `"B"`)
+	})
+}
+
+func TestFinderWarmReplicas(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		empty = []strfmt.UUID(nil)
+	)
+
+	t.Run("AllReachable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, empty).Return([]RepairResponse{}, nil).Once()
+		}
+
+		got, err := finder.WarmReplicas(ctx, shard)
+		assert.Nil(t, err)
+		assert.Equal(t, nodes, got)
+		f.RClient.AssertExpectations(t)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, empty).Return([]RepairResponse{}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, empty).Return([]RepairResponse{}, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, empty).Return([]RepairResponse{}, nil)
+
+		got, err := finder.WarmReplicas(ctx, shard)
+		assert.Equal(t, []string{"A", "C"}, got)
+		assert.ErrorIs(t, err, errAny)
+		assert.ErrorContains(t, err, `"B"`)
+	})
+
+	t.Run("Unresolved", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		f.AddShard(shard, append(slices.Clone(nodes), "X", "Y")) // X and Y have no host
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, empty).Return([]RepairResponse{}, errAny)
+		}
+
+		got, err := finder.WarmReplicas(ctx, shard)
+		assert.Empty(t, got)
+		assert.ErrorIs(t, err, errUnresolvedName)
+		assert.ErrorIs(t, err, errAny)
+		for _, n := range []string{"A", "B", "C", "X", "Y"} {
+			assert.ErrorContains(t, err, `"`+n+`"`)
+		}
+	})
+}
+
+func TestFinderFeasibleLevels(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		empty = []strfmt.UUID(nil)
+	)
+
+	t.Run("AllReachable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, empty).Return([]RepairResponse{}, nil)
+		}
+		got, err := finder.FeasibleLevels(ctx, shard)
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsistencyLevel{All, Quorum, One}, got)
+	})
+
+	t.Run("OneDeadNode", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, empty).Return([]RepairResponse{}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, empty).Return([]RepairResponse{}, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, empty).Return([]RepairResponse{}, nil)
+		got, err := finder.FeasibleLevels(ctx, shard)
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsistencyLevel{Quorum, One}, got)
+	})
+
+	t.Run("AllDead", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, empty).Return([]RepairResponse{}, errAny)
+		}
+		got, err := finder.FeasibleLevels(ctx, shard)
+		assert.Nil(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("UnknownShard", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		_, err := finder.FeasibleLevels(ctx, "unknown")
+		assert.ErrorIs(t, err, errUnknownShard)
+	})
+}
+
+func TestFinderReadReplicasRaw(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		ids   = []strfmt.UUID{"1", "2"}
+	)
+	f := newFakeFactory("C1", shard, nodes)
+	f.AddShard(shard, append(slices.Clone(nodes), "X")) // X has no host
+	finder := f.newFinder("A")
+	digests := map[string][]RepairResponse{
+		"A": {{ID: "1", UpdateTime: 3}, {ID: "2", UpdateTime: 4}},
+		"B": {{ID: "1", UpdateTime: 2}, {ID: "2", UpdateTime: 4}},
+	}
+	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, ids).Return(digests["A"], nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digests["B"], nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return([]RepairResponse(nil), errAny)
+
+	got, err := finder.ReadReplicasRaw(ctx, shard, ids)
+	assert.ErrorIs(t, err, errAny)
+	assert.ErrorContains(t, err, `"C"`)
+	assert.ErrorIs(t, err, errUnresolvedName)
+	assert.ErrorContains(t, err, `"X"`)
+	assert.Equal(t, digests, got)
+	// B is stale but nothing is fetched or repaired
+	f.RClient.AssertNotCalled(t, "FetchObjects", anyVal, anyVal, cls, shard, anyVal)
+	f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, cls, shard, anyVal)
+}
+
+func TestFinderExistsEverywhere(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		id    = strfmt.UUID("1")
+		ids   = []strfmt.UUID{id}
+	)
+
+	t.Run("Everywhere", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for i, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, ids).
+				Return([]RepairResponse{{ID: id.String(), UpdateTime: int64(i + 1)}}, nil)
+		}
+		all, present, err := finder.ExistsEverywhere(ctx, shard, id)
+		assert.NoError(t, err)
+		assert.True(t, all)
+		assert.Equal(t, map[string]bool{"A": true, "B": true, "C": true}, present)
+	})
+
+	t.Run("MissingOnOneNode", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, "A", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String()}}, nil) // missing
+		f.RClient.On("DigestObjects", anyVal, "C", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+
+		all, present, err := finder.ExistsEverywhere(ctx, shard, id)
+		assert.NoError(t, err)
+		assert.False(t, all)
+		assert.Equal(t, map[string]bool{"A": true, "B": false, "C": true}, present)
+		// B is not repaired
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, cls, shard, anyVal)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, "A", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+		f.RClient.On("DigestObjects", anyVal, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+		f.RClient.On("DigestObjects", anyVal, "C", cls, shard, ids).
+			Return([]RepairResponse(nil), errAny)
+
+		all, present, err := finder.ExistsEverywhere(ctx, shard, id)
+		assert.ErrorIs(t, err, errAny)
+		assert.False(t, all)
+		assert.Equal(t, map[string]bool{"A": true, "B": true}, present)
+	})
+}
+
+func TestFinderValidateTopology(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+	)
+
+	t.Run("Consistent", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("ShardReplicas", anyVal, n, cls, shard).Return([]string{"C", "B", "A"}, nil)
+		}
+		assert.NoError(t, finder.ValidateTopology(ctx, shard))
+	})
+
+	t.Run("Disagreement", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("ShardReplicas", anyVal, "A", cls, shard).Return([]string{"A", "B", "C"}, nil)
+		f.RClient.On("ShardReplicas", anyVal, "B", cls, shard).Return([]string{"A", "B"}, nil)
+		f.RClient.On("ShardReplicas", anyVal, "C", cls, shard).Return([]string{"A", "B", "C", "D"}, nil)
+
+		err := finder.ValidateTopology(ctx, shard)
+		assert.ErrorIs(t, err, errTopology)
+		assert.ErrorContains(t, err, `node "B": replica topology mismatch: reports replicas [A B], expected [A B C]`)
+		assert.ErrorContains(t, err, `node "C": replica topology mismatch: reports replicas [A B C D], expected [A B C]`)
+		assert.NotContains(t, err.Error(), `node "A"`)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("ShardReplicas", anyVal, "A", cls, shard).Return([]string{"A", "B", "C"}, nil)
+		f.RClient.On("ShardReplicas", anyVal, "B", cls, shard).Return([]string{"A", "B", "C"}, nil)
+		f.RClient.On("ShardReplicas", anyVal, "C", cls, shard).Return([]string(nil), errAny)
+
+		err := finder.ValidateTopology(ctx, shard)
+		assert.ErrorIs(t, err, errAny)
+		assert.ErrorContains(t, err, `node "C"`)
+	})
+
+	t.Run("Unresolved", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.AddShard(shard, append(slices.Clone(nodes), "X", "Y"))
+		for _, n := range nodes {
+			f.RClient.On("ShardReplicas", anyVal, n, cls, shard).Return([]string{"A", "B", "C", "X", "Y"}, nil)
+		}
+
+		err := finder.ValidateTopology(ctx, shard)
+		assert.ErrorIs(t, err, errUnresolvedName)
+		assert.ErrorContains(t, err, `node "X"`)
+		assert.ErrorContains(t, err, `node "Y"`)
+		assert.NotContains(t, err.Error(), `node "A"`)
+	})
+}
+
+func TestFinderGetAllDigest(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		ids   = []strfmt.UUID{"1", "2", "3"}
+	)
+	f := newFakeFactory("C1", shard, nodes)
+	finder := f.newFinder("A")
+	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, ids).Return([]RepairResponse{
+		{ID: "1", UpdateTime: 3}, {ID: "2", UpdateTime: 4}, {ID: "3"},
+	}, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return([]RepairResponse{
+		{ID: "1", UpdateTime: 2}, {ID: "2", UpdateTime: 5}, {ID: "3"},
+	}, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return([]RepairResponse{
+		{ID: "1", UpdateTime: 3}, {ID: "2", UpdateTime: 4}, {ID: "3"},
+	}, nil)
+
+	got, err := finder.GetAllDigest(ctx, All, shard, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []RepairResponse{{ID: "1", UpdateTime: 3}, {ID: "2", UpdateTime: 5}, {ID: "3"}}, got)
+	f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, cls, shard, anyVal, anyVal, anyVal)
+	f.RClient.AssertNotCalled(t, "FetchObjects", anyVal, anyVal, cls, shard, anyVal)
+	f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, cls, shard, anyVal)
+
+	t.Run("Unavailable", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids).Return([]RepairResponse(nil), errAny)
+		_, err := finder.GetAllDigest(ctx, Quorum, shard, ids)
+		assert.ErrorIs(t, err, errRead)
+	})
+}
+
+func TestFinderGetAllWindowed(t *testing.T) {
+	var (
+		cls    = "C1"
+		shard  = "SH1"
+		nodes  = []string{"A", "B", "C"}
+		ctx    = context.Background()
+		ids    = []strfmt.UUID{"1", "2", "3", "4", "5"}
+		window = 2
+		f      = newFakeFactory("C1", shard, nodes)
+		finder = f.newFinder("A")
+		got    []strfmt.UUID
+		sizes  []int
+	)
+	for start := 0; start < len(ids); start += window {
+		part := ids[start:min(start+window, len(ids))]
+		var (
+			fetched  = make([]objects.Replica, len(part))
+			digestR2 = make([]RepairResponse, len(part))
+			digestR3 = make([]RepairResponse, len(part))
+		)
+		for i, id := range part {
+			fetched[i] = replica(id, 5, false)
+			digestR2[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+			digestR3[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+			if id == "3" { // B is stale
+				digestR2[i].UpdateTime = 1
+			}
+		}
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, part).Return(fetched, nil).Once()
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, part).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, part).Return(digestR3, nil)
+	}
+	f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return([]RepairResponse{}, nil).Once()
+
+	err := finder.GetAll(ctx, All, shard, ids, window, func(objs []*storobj.Object) error {
+		sizes = append(sizes, len(objs))
+		for _, x := range objs {
+			require.True(t, x.IsConsistent)
+			got = append(got, x.ID())
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ids, got)
+	require.Equal(t, []int{2, 2, 1}, sizes)
+	f.RClient.AssertNumberOfCalls(t, "FetchObjects", 3)
+	f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+}
+
+func TestFinderGetAllAbsentOnReadReplica(t *testing.T) {
+	var (
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A", "B", "C"}
+		ctx     = context.Background()
+		ids     = []strfmt.UUID{"1", "2", "3"}
+		fetched = []objects.Replica{replica("1", 5, false), {ID: "2"}, replica("3", 5, true)}
+		f       = newFakeFactory("C1", shard, nodes)
+		finder  = f.newFinder("A")
+		got     []strfmt.UUID
+	)
+	f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, ids).Return(fetched, nil)
+	f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids[:1]).
+		Return([]RepairResponse{{ID: "1", UpdateTime: 5}}, nil)
+	// A missed the creation of 2, B and C hold it, 3 is deleted everywhere
+	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, ids[1:]).
+		Return([]RepairResponse{{ID: "2"}, {ID: "3", UpdateTime: 5, Deleted: true}}, nil)
+	f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids[1:]).
+		Return([]RepairResponse{{ID: "2", UpdateTime: 4}, {ID: "3", UpdateTime: 5, Deleted: true}}, nil)
+	f.RClient.On("FetchObjects", anyVal, anyVal, cls, shard, ids[1:2]).
+		Return([]objects.Replica{replica(ids[1], 4, false)}, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, ids[1:2]).
+		Return([]RepairResponse{{ID: "2"}}, nil)
+	f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids[1:2]).
+		Return([]RepairResponse{{ID: "2", UpdateTime: 4}}, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).
+		Return([]RepairResponse{}, nil)
+
+	err := finder.GetAll(ctx, All, shard, ids, 0, func(objs []*storobj.Object) error {
+		for _, x := range objs {
+			require.True(t, x.IsConsistent)
+			got = append(got, x.ID())
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ids[:2], got)
+	// the missing objects are read in a single batch
+	f.RClient.AssertNumberOfCalls(t, "FetchObjects", 2)
+	f.RClient.AssertNumberOfCalls(t, "FetchObject", 0)
+	f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+
+	t.Run("One", func(t *testing.T) {
+		got = nil
+		err := finder.GetAll(ctx, One, shard, ids, 0, func(objs []*storobj.Object) error {
+			for _, x := range objs {
+				got = append(got, x.ID())
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, ids[:1], got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObjects", 3)
+	})
+}
+
+func TestFinderGetAllCollectors(t *testing.T) {
+	var (
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A", "B", "C"}
+		ctx     = context.Background()
+		ids     = []strfmt.UUID{"1", "2"}
+		f       = newFakeFactory("C1", shard, nodes)
+		finder  = f.newFinder("A")
+		acks    []string
+		stale   map[strfmt.UUID]time.Duration
+		winners map[strfmt.UUID]string
+	)
+	for _, id := range ids {
+		part := []strfmt.UUID{id}
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, part).
+			Return([]objects.Replica{replica(id, 5, false)}, nil)
+		f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, part).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 5}}, nil)
+	}
+
+	err := finder.GetAll(ctx, All, shard, ids, 1, func([]*storobj.Object) error { return nil },
+		WithAcks(&acks), WithStaleness(&stale), WithWinners(&winners))
+	require.NoError(t, err)
+	assert.Equal(t, nodes, acks)
+	// every window is reported, not only the last one
+	assert.Equal(t, map[strfmt.UUID]time.Duration{"1": 0, "2": 0}, stale)
+	assert.Len(t, winners, len(ids))
+}
+
+func TestFinderGetAllMaxRepairRounds(t *testing.T) {
+	var (
+		cls        = "C1"
+		shard      = "SH1"
+		nodes      = []string{"A", "B", "C"}
+		ctx        = context.Background()
+		ids        = []strfmt.UUID{"1", "2", "3"}
+		f          = newFakeFactory("C1", shard, nodes)
+		finder     = f.newFinder("A")
+		incomplete bool
+		got        []*storobj.Object
+	)
+	// C is stale for every object
+	for _, id := range ids {
+		part := []strfmt.UUID{id}
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, part).
+			Return([]objects.Replica{replica(id, 5, false)}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, part).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 5}}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, part).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+	}
+	f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+
+	err := finder.GetAll(ctx, All, shard, ids, 1, func(xs []*storobj.Object) error {
+		got = append(got, xs...)
+		return nil
+	}, WithMaxRepairRounds(2, &incomplete))
+	require.NoError(t, err)
+	assert.True(t, incomplete)
+	// the windows after the second one are not repaired
+	f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 2)
+	require.Len(t, got, len(ids))
+	for i, x := range got {
+		assert.Equal(t, i < 2, x.IsConsistent, ids[i])
+	}
+}
+
+func TestFinderGetAllOrder(t *testing.T) {
+	var (
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A", "B", "C"}
+		ctx     = context.Background()
+		ids     = []strfmt.UUID{"2", "4", "1", "3"}
+		times   = []int64{3, 4, 1, 2}
+		fetched = make([]objects.Replica, len(ids))
+		digestR = make([]RepairResponse, len(ids))
+		f       = newFakeFactory("C1", shard, nodes)
+		finder  = f.newFinder("A")
+	)
+	for i, id := range ids {
+		fetched[i] = replica(id, times[i], false)
+		digestR[i] = RepairResponse{ID: id.String(), UpdateTime: times[i]}
+	}
+	f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, ids).Return(fetched, nil)
+	f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids).Return(digestR, nil)
+
+	for _, tc := range []struct {
+		order Order
+		want  []strfmt.UUID
+	}{
+		{OrderInput, ids},
+		{OrderUpdateTimeDesc, []strfmt.UUID{"4", "2", "3", "1"}},
+		{OrderIDAsc, []strfmt.UUID{"1", "2", "3", "4"}},
+	} {
+		var got []strfmt.UUID
+		var uTimes []int64
+		err := finder.GetAll(ctx, All, shard, ids, 0, func(objs []*storobj.Object) error {
+			for _, x := range objs {
+				got = append(got, x.ID())
+				uTimes = append(uTimes, x.LastUpdateTimeUnix())
+			}
+			return nil
+		}, WithOrder(tc.order))
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got)
+		if tc.order == OrderUpdateTimeDesc {
+			require.Equal(t, []int64{4, 3, 2, 1}, uTimes)
+		}
+	}
+}
+
+func TestFinderRetryFailed(t *testing.T) {
+	var (
+		cls    = "C1"
+		shard  = "SH1"
+		nodes  = []string{"A", "B", "C"}
+		ctx    = context.Background()
+		ids    = []strfmt.UUID{"1", "2", "3", "4"}
+		f      = newFakeFactory("C1", shard, nodes)
+		finder = f.newFinder("A", WithRepairErrors())
+		repErr *RepairError
+	)
+	digests := func(ids []strfmt.UUID, stale ...strfmt.UUID) []RepairResponse {
+		xs := make([]RepairResponse, len(ids))
+		for i, id := range ids {
+			xs[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+			if slices.Contains(stale, id) {
+				xs[i].UpdateTime = 1
+			}
+		}
+		return xs
+	}
+	for _, part := range [][]strfmt.UUID{ids[:2], ids[2:], ids[1:2]} {
+		fetched := make([]objects.Replica, len(part))
+		for i, id := range part {
+			fetched[i] = replica(id, 5, false)
+		}
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, part).Return(fetched, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, part).Return(digests(part), nil)
+	}
+	// B is stale on 2 and 3, the repair of 2 fails, B holds it by the time it is retried
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids[:2]).Return(digests(ids[:2], "2"), nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids[2:]).Return(digests(ids[2:], "3"), nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids[1:2]).Return(digests(ids[1:2]), nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).
+		Return([]RepairResponse{{ID: "2", Err: "conflict"}}, nil).Once()
+	f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).
+		Return([]RepairResponse{}, nil).Once()
+
+	var got []strfmt.UUID
+	err := finder.GetAll(ctx, All, shard, ids, 2, func(objs []*storobj.Object) error {
+		for _, x := range objs {
+			got = append(got, x.ID())
+		}
+		return nil
+	})
+	require.ErrorAs(t, err, &repErr)
+	require.Equal(t, ids, got)
+	require.Equal(t, []strfmt.UUID{"2"}, repErr.IDs())
+
+	objs, err := finder.RetryFailed(ctx, All, shard, err)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	require.Equal(t, strfmt.UUID("2"), objs[0].ID())
+	require.True(t, objs[0].IsConsistent)
+	// only the failed object has been requested again
+	f.RClient.AssertNumberOfCalls(t, "FetchObjects", 3)
+	f.RClient.AssertCalled(t, "FetchObjects", anyVal, nodes[0], cls, shard, ids[1:2])
+
+	objs, err = finder.RetryFailed(ctx, All, shard, nil)
+	require.NoError(t, err)
+	require.Nil(t, objs)
+	_, err = finder.RetryFailed(ctx, All, shard, errAny)
+	require.ErrorIs(t, err, errAny)
+}
+
+func TestFinderCheckConsistencyEmptyReplies(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		ids   = []strfmt.UUID{"1", "2"}
+	)
+
+	t.Run("AbsentOnPeers", func(t *testing.T) {
+		var (
+			f           = newFakeFactory("C1", shard, nodes)
+			finder      = f.newFinder("A")
+			xs, digestR = genInputs("A", shard, 1, ids)
+			want        = setObjectsConsistency(xs, true)
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return([]RepairResponse{}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+
+		err := finder.CheckConsistency(ctx, All, xs)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, want, xs)
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal)
+	})
+
+	t.Run("MissingResults", func(t *testing.T) {
+		var (
+			f           = newFakeFactory("C1", shard, nodes)
+			finder      = f.newFinder("A")
+			xs, digestR = genInputs("A", shard, 1, ids)
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR[:1], nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, nil)
+
+		err := finder.CheckConsistency(ctx, All, xs)
+		assert.ErrorIs(t, err, errRead)
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder("A")
+			got    []*storobj.Object
+		)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, ids).Return([]objects.Replica{}, nil)
+
+		err := finder.GetAll(ctx, One, shard, ids, 0, func(xs []*storobj.Object) error {
+			got = append(got, xs...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
 }
 
-func TestFinderNodeObject(t *testing.T) {
+func TestFinderCompareNodes(t *testing.T) {
 	var (
-		id    = strfmt.UUID("123")
 		cls   = "C1"
 		shard = "SH1"
 		nodes = []string{"A", "B", "C"}
 		ctx   = context.Background()
-		r     = objects.Replica{ID: id, Object: object(id, 3)}
-		adds  = additional.Properties{}
-		proj  = search.SelectProperties{}
+		ids   = []strfmt.UUID{"1", "2", "3", "4"}
 	)
+	f := newFakeFactory("C1", shard, nodes)
+	finder := f.newFinder("A")
+	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, ids).Return([]RepairResponse{
+		{ID: "1", UpdateTime: 3}, {ID: "2", UpdateTime: 4}, {ID: "3", UpdateTime: 5}, {ID: "4"},
+	}, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return([]RepairResponse{
+		{ID: "1", UpdateTime: 2}, {ID: "2", UpdateTime: 6}, {ID: "3", UpdateTime: 5}, {ID: "4", UpdateTime: 1},
+	}, nil)
+
+	got, err := finder.CompareNodes(ctx, shard, ids, "A", "C")
+	require.NoError(t, err)
+	assert.Equal(t, NodeDiff{
+		AAhead: []strfmt.UUID{"1"},
+		BAhead: []strfmt.UUID{"2", "4"},
+		Equal:  []strfmt.UUID{"3"},
+	}, got)
+	f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, nodes[1], cls, shard, ids)
+
+	t.Run("Unreachable", func(t *testing.T) {
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return([]RepairResponse(nil), errAny)
+		_, err := finder.CompareNodes(ctx, shard, ids, "A", "B")
+		assert.ErrorIs(t, err, errAny)
+		assert.ErrorContains(t, err, `"B"`)
+	})
 
 	t.Run("Unresolved", func(t *testing.T) {
 		f := newFakeFactory("C1", shard, nodes)
 		finder := f.newFinder("A")
-		_, err := finder.NodeObject(ctx, "N", "S", "id", nil, additional.Properties{})
-		assert.Contains(t, err.Error(), "N")
+		_, err := finder.CompareNodes(ctx, shard, ids, "A", "D")
+		assert.ErrorIs(t, err, errUnresolvedName)
+		assert.ErrorContains(t, err, `"D"`)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, nodes[0], cls, shard, ids)
 	})
+}
 
-	t.Run("Success", func(t *testing.T) {
+func TestFinderForceOverwrite(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		objs  = []*objects.VObject{
+			{ID: "1", LastUpdateTimeUnixMilli: 5, LatestObject: &object("1", 5).Object},
+			{ID: "2", LastUpdateTimeUnixMilli: 6, LatestObject: &object("2", 6).Object},
+		}
+	)
+
+	t.Run("AllTargets", func(t *testing.T) {
 		f := newFakeFactory("C1", shard, nodes)
 		finder := f.newFinder("A")
-		for _, n := range nodes {
-			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(r, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("OverwriteObjects", anyVal, n, cls, shard, objs).Return([]RepairResponse{}, nil).Once()
 		}
-		got, err := finder.NodeObject(ctx, nodes[0], shard, id, proj, adds)
+
+		got, err := finder.ForceOverwrite(ctx, shard, objs, []string{"B", "C"})
 		assert.Nil(t, err)
-		assert.Equal(t, r.Object, got)
+		assert.Equal(t, map[string]error{"B": nil, "C": nil}, got)
+		f.RClient.AssertExpectations(t)
+		// digests are not compared
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, anyVal)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, "A", cls, shard, anyVal)
+	})
+
+	t.Run("PartialFailure", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("OverwriteObjects", anyVal, "A", cls, shard, objs).Return([]RepairResponse{}, nil)
+		f.RClient.On("OverwriteObjects", anyVal, "B", cls, shard, objs).Return([]RepairResponse{}, errAny)
+		f.RClient.On("OverwriteObjects", anyVal, "C", cls, shard, objs).
+			Return([]RepairResponse{{ID: "2", Err: "conflict"}}, nil)
+
+		got, err := finder.ForceOverwrite(ctx, shard, objs, []string{"A", "B", "C", "X"})
+		assert.ErrorIs(t, err, errRepair)
+		assert.ErrorContains(t, err, "[B C X]")
+		assert.Nil(t, got["A"])
+		assert.ErrorIs(t, got["B"], errAny)
+		assert.ErrorContains(t, got["C"], "object 2: conflict")
+		assert.ErrorIs(t, got["X"], errUnresolvedName)
 	})
 }
 
@@ -171,6 +1006,46 @@ func TestFinderGetOneWithConsistencyLevelALL(t *testing.T) {
 		assert.Equal(t, item.Object, got)
 	})
 
+	t.Run("DegradeToQuorum", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			prov      Provenance
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithDegradeToQuorum(), WithProvenance(&prov))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.Equal(t, Provenance{Level: Quorum, Nodes: []string{"A", "C"}}, prov)
+		f.assertLogErrorContains(t, "reading at level QUORUM")
+	})
+
+	t.Run("Provenance", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			prov      Provenance
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		// served at the requested level
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithDegradeToQuorum(), WithProvenance(&prov))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.Equal(t, Provenance{Level: All, Nodes: nodes}, prov)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
@@ -269,6 +1144,178 @@ func TestFinderGetOneWithConsistencyLevelQuorum(t *testing.T) {
 		assert.Equal(t, nilObject, got)
 	})
 
+	t.Run("SoleSurvivor", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			prov      Provenance
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithSoleSurvivor(), WithProvenance(&prov))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.False(t, got.IsConsistent)
+		assert.Equal(t, Provenance{Level: One, Nodes: []string{"A"}}, prov)
+		f.assertLogErrorContains(t, "sole surviving replica")
+	})
+
+	t.Run("SoleSurvivorNotNeeded", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithSoleSurvivor())
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.True(t, got.IsConsistent)
+	})
+
+	t.Run("DuplicateHost", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		// C resolves to the host of A, e.g. while membership is changing
+		finder.resolver.nodeResolver.(*fakeNodeResolver).hosts["C"] = "A"
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+
+		// A must not acknowledge the read twice
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+		assert.ErrorIs(t, err, errRead)
+		assert.Equal(t, nilObject, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, nodes[0], cls, shard, digestIDs)
+	})
+
+	t.Run("ToleratedFailures", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		n, err := finder.ToleratedFailures(Quorum, shard)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+
+		_, err = finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+		assert.ErrorIs(t, err, errRead)
+		assert.ErrorContains(t, err, "more than 1 of 3 replicas failed")
+	})
+
+	t.Run("MaxFailures", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		// a single failure is tolerated at quorum, but not with WithMaxFailures(0)
+		_, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithMaxFailures(0))
+		assert.ErrorIs(t, err, errRead)
+		assert.ErrorContains(t, err, "more than 0 of 3 replicas failed")
+	})
+
+	t.Run("Acks", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			acks      []string
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithAcks(&acks))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		assert.Equal(t, []string{"A", "B"}, acks)
+	})
+
+	t.Run("MetadataOnly", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			metaAdds  = additional.Properties{NoProps: true, CreationTimeUnix: true, LastUpdateTimeUnix: true}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, search.SelectProperties(nil), metaAdds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		props := search.SelectProperties{{Name: "name"}}
+		got, err := finder.GetOne(ctx, Quorum, shard, id, props, additional.Properties{Vector: true}, WithMetadataOnly())
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertCalled(t, "FetchObject", anyVal, nodes[0], cls, shard, id, search.SelectProperties(nil), metaAdds)
+	})
+
+	t.Run("MinAcksAboveAvailableReplicas", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder("A")
+		)
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(4))
+		assert.ErrorIs(t, err, errReplicas)
+		assert.Equal(t, nilObject, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, cls, shard, id, proj, adds)
+	})
+
+	t.Run("MinAcksRaisesLevel", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		// quorum is satisfied by A and C, but all three replicas are required
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(3))
+		assert.ErrorIs(t, err, errRead)
+		assert.Equal(t, nilObject, got)
+
+		// a lower value never lowers the consistency level
+		got, err = finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(1))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
@@ -286,21 +1333,79 @@ func TestFinderGetOneWithConsistencyLevelQuorum(t *testing.T) {
 		assert.Equal(t, item.Object, got)
 	})
 
-	t.Run("NotFound", func(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			// obj       = object(id, 3)
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 0}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, nilObject, got)
+	})
+
+	t.Run("GenerationMismatch", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR1  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Generation: 1}}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Generation: 2}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR1, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithGeneration(2))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[2], cls, shard, digestIDs)
+	})
+
+	t.Run("GenerationMismatchOnAllPeers", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR1  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Generation: 1}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR1, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR1, nil)
+
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithGeneration(2))
+		assert.ErrorIs(t, err, errRead)
+		assert.Equal(t, nilObject, got)
+		f.assertLogErrorContains(t, errGeneration.Error())
+	})
+
+	t.Run("GenerationMismatchOnFullRead", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
 			finder    = f.newFinder("A")
 			digestIDs = []strfmt.UUID{id}
-			// obj       = object(id, 3)
-			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 0}}
+			stale     = objects.Replica{ID: id, Object: object(id, 3), Generation: 1}
+			item      = objects.Replica{ID: id, Object: object(id, 3), Generation: 2}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3, Generation: 2}}
 		)
-		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, nil)
+		stale.Object.Object.Properties = map[string]interface{}{"generation": "stale"}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(stale, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
-		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
 
-		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+		got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds, WithGeneration(2))
 		assert.Nil(t, err)
-		assert.Equal(t, nilObject, got)
+		assert.Equal(t, item.Object, got)
 	})
 
 	// succeeds via Fetch0+Digest1
@@ -385,35 +1490,584 @@ func TestFinderGetOneWithConsistencyLevelQuorum(t *testing.T) {
 	// 	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
 	// 	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, errAny)
 
-	// 	got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
-	// 	assert.Nil(t, err)
-	// 	assert.Equal(t, item.Object, got)
-	// })
+	// 	got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+	// 	assert.Nil(t, err)
+	// 	assert.Equal(t, item.Object, got)
+	// })
+
+	// investigate flakiness
+	// succeeds via Fetch1+Digest2
+	// t.Run("Fetch02Digest01Fail", func(t *testing.T) {
+	// 	var (
+	// 		f         = newFakeFactory("C1", shard, nodes)
+	// 		finder    = f.newFinder("A")
+	// 		digestIDs = []strfmt.UUID{id}
+	// 		item      = objects.Replica{ID: id, Object: object(id, 3)}
+	// 		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	// 	)
+	// 	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, errAny)
+	// 	f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item, nil)
+	// 	f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(emptyItem, errAny)
+	// 	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR, errAny)
+	// 	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
+	// 	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+	// 	got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
+	// 	assert.Nil(t, err)
+	// 	assert.Equal(t, item.Object, got)
+	// })
+}
+
+func TestFinderGetOneWithConsistencyLevelOne(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		nilObject *storobj.Object
+		emptyItem = objects.Replica{}
+	)
+
+	t.Run("None", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder("A")
+			// obj    = objects.Replica{ID: id, Object: object(id, 3)
+		)
+		for _, n := range nodes {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(emptyItem, errAny)
+		}
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.ErrorIs(t, err, errRead)
+		f.assertLogErrorContains(t, errAny.Error())
+		assert.Equal(t, nilObject, got)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder(nodes[2])
+			item   = objects.Replica{ID: id, Object: object(id, 3)}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item, nil)
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder("A")
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, nil)
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, nilObject, got)
+	})
+
+	t.Run("MaxAge", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A")
+			stale   = objects.Replica{ID: id, Object: object(id, 1000)}
+			fresh   = objects.Replica{ID: id, Object: object(id, 5000)}
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 5000}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(stale, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, []strfmt.UUID{id}).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(fresh, nil)
+		}
+
+		// A is 4s behind its peer
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds, WithMaxAge(time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, fresh.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 1)
+
+		// within the bound the first replica is served
+		got, err = finder.GetOne(ctx, One, shard, id, proj, adds, WithMaxAge(5*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, stale.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 3)
+	})
+
+	t.Run("MaxAgeOtherGeneration", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A")
+			stale   = objects.Replica{ID: id, Object: object(id, 1000), Generation: 2}
+			fresh   = objects.Replica{ID: id, Object: object(id, 5000), Generation: 1}
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 5000, Generation: 1}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(stale, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, []strfmt.UUID{id}).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(fresh, nil)
+		}
+
+		// the peer holds a copy of another generation, it does not bound staleness
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds, WithMaxAge(time.Second), WithGeneration(2))
+		assert.Nil(t, err)
+		assert.Equal(t, stale.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+	})
+
+	t.Run("HedgedRead", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithHedgedReads(10*time.Millisecond))
+			item      = objects.Replica{ID: id, Object: object(id, 2)}
+			cancelled = make(chan struct{})
+		)
+		// first node is slow and gets cancelled once the hedge returns
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, errAny).
+			Run(func(a mock.Arguments) {
+				<-a[0].(context.Context).Done()
+				close(cancelled)
+			})
+		for _, n := range nodes[1:] {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow read was not cancelled")
+		}
+	})
+
+	t.Run("HedgedReadRetryBudget", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			finder = f.newFinder("A", WithHedgedReads(time.Hour))
+			item   = objects.Replica{ID: id, Object: object(id, 2)}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, errAny)
+		for _, n := range nodes[1:] {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
+
+		// the hedged request after the failure of A is charged against the budget
+		_, err := finder.GetOne(ctx, One, shard, id, proj, adds, WithRetryBudget(0))
+		assert.ErrorIs(t, err, errRead)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds, WithRetryBudget(1))
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 3)
+	})
+
+	t.Run("Verification", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithOneVerificationRate(1))
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			verified  = make(chan string, len(nodes))
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil).
+				Run(func(a mock.Arguments) { verified <- a[1].(string) })
+		}
+
+		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.Equal(t, item.Object, got)
+
+		select {
+		case host := <-verified:
+			assert.Contains(t, nodes[1:], host)
+		case <-time.After(time.Second):
+			t.Fatal("background digest was not issued")
+		}
+		f.assertLogContains(t, "msg", "A:3", ":2")
+	})
+}
+
+func TestFinderGetOneWithBackgroundRepair(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		f         = newFakeFactory("C1", shard, nodes)
+		finder    = f.newFinder("A")
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		release   = make(chan struct{})
+		repaired  = make(chan struct{})
+	)
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil).
+		Run(func(mock.Arguments) { <-release })
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil).
+		Run(func(mock.Arguments) { close(repaired) })
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithBackgroundRepair())
+	require.NoError(t, err)
+	require.Equal(t, item.Object, got)
+	f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+
+	close(release)
+	select {
+	case <-repaired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale replica was not repaired in the background")
+	}
+}
+
+func TestFinderGetOneWithBackgroundRepairTargets(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		f         = newFakeFactory("C1", shard, nodes)
+		finder    = f.newFinder("A")
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		repaired  = make(chan struct{})
+	)
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR2, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR2, nil).
+		Run(func(mock.Arguments) { close(repaired) })
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithBackgroundRepair(), WithRepairTargets("C"))
+	require.NoError(t, err)
+	require.Equal(t, item.Object, got)
+
+	select {
+	case <-repaired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale replica was not repaired in the background")
+	}
+	// B is stale as well but only C is repaired
+	require.NoError(t, finder.Close(ctx))
+	f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+}
+
+func TestFinderGetOneWithConfirmation(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		f         = newFakeFactory("C1", shard, nodes)
+		finder    = f.newFinder("A")
+		digestIDs = []strfmt.UUID{id}
+		item2     = objects.Replica{ID: id, Object: object(id, 2)}
+		item3     = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		confirmed = make(chan *storobj.Object, 1)
+	)
+	// A is stale
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+	f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+	f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR2, nil)
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds,
+		WithConfirmation(func(obj *storobj.Object, err error) {
+			assert.NoError(t, err)
+			confirmed <- obj
+		}))
+	require.NoError(t, err)
+	require.Equal(t, item2.Object, got)
+
+	select {
+	case obj := <-confirmed:
+		assert.Equal(t, item3.Object, obj)
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal)
+	case <-time.After(5 * time.Second):
+		t.Fatal("confirmation callback not called")
+	}
+}
+
+func TestFinderGetOneWithConfirmationKeepsOptions(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		f         = newFakeFactory("C1", shard, nodes)
+		finder    = f.newFinder("A")
+		digestIDs = []strfmt.UUID{id}
+		item2     = objects.Replica{ID: id, Object: object(id, 2)}
+		item3     = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		acks      []string
+		confirmed = make(chan *storobj.Object, 1)
+	)
+	// A and B are stale
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+	f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+	f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR2, nil)
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithRepairTargets("A"), WithAcks(&acks),
+		WithConfirmation(func(obj *storobj.Object, err error) {
+			assert.NoError(t, err)
+			confirmed <- obj
+		}))
+	require.NoError(t, err)
+	require.Equal(t, item2.Object, got)
+
+	select {
+	case obj := <-confirmed:
+		assert.Equal(t, item3.Object, obj)
+		// the background read repairs the targets only
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+		// and does not report to the outputs of the served read
+		assert.Equal(t, []string{"A"}, acks)
+	case <-time.After(5 * time.Second):
+		t.Fatal("confirmation callback not called")
+	}
+}
+
+func TestFinderGetOneWithNodeAffinity(t *testing.T) {
+	var (
+		id     = strfmt.UUID("123")
+		cls    = "C1"
+		shard  = "SH1"
+		nodes  = []string{"A", "B", "C"}
+		ctx    = context.Background()
+		adds   = additional.Properties{}
+		proj   = search.SelectProperties{}
+		f      = newFakeFactory("C1", shard, nodes)
+		finder = f.newFinder("X", WithNodeAffinity()) // X holds no replica
+		item   = objects.Replica{ID: id, Object: object(id, 3)}
+		mu     sync.Mutex
+		served []string
+	)
+	record := func(a mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		served = append(served, a[1].(string))
+	}
+	for _, n := range nodes {
+		f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil).Run(record)
+	}
+
+	for i := 0; i < 5; i++ {
+		_, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		require.NoError(t, err)
+	}
+	first := served[0]
+	assert.Equal(t, []string{first, first, first, first, first}, served)
+
+	// the preferred node is given up once it fails
+	f.RClient.On("FetchObject", anyVal, first, cls, shard, id, proj, adds).Unset()
+	f.RClient.On("FetchObject", anyVal, first, cls, shard, id, proj, adds).Return(objects.Replica{}, errAny)
+	served = nil
+	for i := 0; i < 3; i++ {
+		_, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+		require.NoError(t, err)
+	}
+	require.Len(t, served, 3)
+	assert.NotEqual(t, first, served[0])
+	assert.Equal(t, []string{served[0], served[0], served[0]}, served)
+}
+
+// countingBackoff counts the delays requested from it
+type countingBackoff struct {
+	interval.Backoff
+	next *atomic.Int32
+}
+
+func (b countingBackoff) Next() time.Duration {
+	b.next.Add(1)
+	return b.Backoff.Next()
+}
+
+func TestFinderGetOneWithBackoff(t *testing.T) {
+	var (
+		id      = strfmt.UUID("123")
+		cls     = "C1"
+		shard   = "SH1"
+		nodes   = []string{"A"}
+		ctx     = context.Background()
+		adds    = additional.Properties{}
+		proj    = search.SelectProperties{}
+		f       = newFakeFactory("C1", shard, nodes)
+		next    atomic.Int32
+		created atomic.Int32
+		finder  = f.newFinder("A", WithBackoff(func() interval.Backoff {
+			created.Add(1)
+			return countingBackoff{interval.NewConstantBackoff(time.Millisecond), &next}
+		}))
+		item = objects.Replica{ID: id, Object: object(id, 3)}
+	)
+	// the first retry is immediate, only the second one waits
+	f.RClient.On("FetchObject", anyVal, "A", cls, shard, id, proj, adds).Return(objects.Replica{}, errAny).Twice()
+	f.RClient.On("FetchObject", anyVal, "A", cls, shard, id, proj, adds).Return(item, nil)
+
+	got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
+	require.NoError(t, err)
+	assert.Equal(t, item.Object, got)
+	assert.Equal(t, int32(1), created.Load())
+	assert.Equal(t, int32(1), next.Load())
+}
+
+func TestFinderGetOneWithVectorNodes(t *testing.T) {
+	var (
+		id     = strfmt.UUID("123")
+		cls    = "C1"
+		shard  = "SH1"
+		nodes  = []string{"A", "B", "C"}
+		ctx    = context.Background()
+		proj   = search.SelectProperties{}
+		f      = newFakeFactory("C1", shard, nodes)
+		finder = f.newFinder("A", WithVectorNodes(map[string]string{shard: "B"}))
+		item   = objects.Replica{ID: id, Object: object(id, 3)}
+		mu     sync.Mutex
+		served []string
+	)
+	record := func(a mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		served = append(served, a[1].(string))
+	}
+	for _, n := range nodes {
+		f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, anyVal).Return(item, nil).Run(record)
+	}
+
+	_, err := finder.GetOne(ctx, One, shard, id, proj, additional.Properties{Vector: true})
+	require.NoError(t, err)
+	_, err = finder.GetOne(ctx, One, shard, id, proj, additional.Properties{Vectors: []string{"text"}})
+	require.NoError(t, err)
+	_, err = finder.GetOne(ctx, One, shard, id, proj, additional.Properties{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"B", "B", "A"}, served)
+}
+
+func TestFinderClose(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("DrainBackgroundRepair", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A")
+			started = make(chan struct{})
+			done    = make(chan struct{})
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil).
+			Run(func(mock.Arguments) {
+				close(started)
+				time.Sleep(50 * time.Millisecond)
+				close(done)
+			})
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		_, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithBackgroundRepair())
+		require.NoError(t, err)
+		<-started
+		require.NoError(t, finder.Close(ctx))
+		select {
+		case <-done:
+		default:
+			t.Fatal("background repair still running after Close returned")
+		}
+	})
+
+	t.Run("CancelBackgroundRepair", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			started   = make(chan struct{})
+			cancelled = make(chan struct{})
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil).
+			Run(func(a mock.Arguments) {
+				close(started)
+				<-a[0].(context.Context).Done()
+				close(cancelled)
+			})
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+		_, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithBackgroundRepair())
+		require.NoError(t, err)
+		<-started
+		closeCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, finder.Close(closeCtx), context.DeadlineExceeded)
+		select {
+		case <-cancelled:
+		default:
+			t.Fatal("background repair not cancelled after Close returned")
+		}
 
-	// investigate flakiness
-	// succeeds via Fetch1+Digest2
-	// t.Run("Fetch02Digest01Fail", func(t *testing.T) {
-	// 	var (
-	// 		f         = newFakeFactory("C1", shard, nodes)
-	// 		finder    = f.newFinder("A")
-	// 		digestIDs = []strfmt.UUID{id}
-	// 		item      = objects.Replica{ID: id, Object: object(id, 3)}
-	// 		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
-	// 	)
-	// 	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, errAny)
-	// 	f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item, nil)
-	// 	f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(emptyItem, errAny)
-	// 	f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR, errAny)
-	// 	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, errAny)
-	// 	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+		// no background work is started once closed
+		_, err = finder.GetOne(ctx, All, shard, id, proj, adds, WithBackgroundRepair())
+		require.NoError(t, err)
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 2)
+	})
+}
 
-	// 	got, err := finder.GetOne(ctx, Quorum, shard, id, proj, adds)
-	// 	assert.Nil(t, err)
-	// 	assert.Equal(t, item.Object, got)
-	// })
+type fakeLocalReader struct {
+	object  objects.Replica
+	digests []RepairResponse
+	err     error
 }
 
-func TestFinderGetOneWithConsistencyLevelOne(t *testing.T) {
+func (r *fakeLocalReader) FetchObject(ctx context.Context, shard string, id strfmt.UUID) (objects.Replica, error) {
+	return r.object, r.err
+}
+
+func (r *fakeLocalReader) DigestObjects(ctx context.Context, shard string, ids []strfmt.UUID) ([]RepairResponse, error) {
+	return r.digests, r.err
+}
+
+func TestFinderLocalReader(t *testing.T) {
 	var (
 		id        = strfmt.UUID("123")
 		cls       = "C1"
@@ -422,48 +2076,189 @@ func TestFinderGetOneWithConsistencyLevelOne(t *testing.T) {
 		ctx       = context.Background()
 		adds      = additional.Properties{}
 		proj      = search.SelectProperties{}
-		nilObject *storobj.Object
-		emptyItem = objects.Replica{}
+		digestIDs = []strfmt.UUID{id}
 	)
 
-	t.Run("None", func(t *testing.T) {
+	t.Run("ExistsLocally", func(t *testing.T) {
 		var (
 			f      = newFakeFactory("C1", shard, nodes)
-			finder = f.newFinder("A")
-			// obj    = objects.Replica{ID: id, Object: object(id, 3)
+			local  = &fakeLocalReader{digests: []RepairResponse{{ID: id.String(), UpdateTime: 3}}}
+			finder = f.newFinder("A", WithLocalReader(local))
+		)
+		got, err := finder.Exists(ctx, One, shard, id)
+		require.NoError(t, err)
+		require.True(t, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("MissingLocally", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			local   = &fakeLocalReader{digests: []RepairResponse{{ID: id.String()}}}
+			finder  = f.newFinder("A", WithLocalReader(local))
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
 		)
 		for _, n := range nodes {
-			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(emptyItem, errAny)
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
 		}
-
-		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
-		assert.ErrorIs(t, err, errRead)
-		f.assertLogErrorContains(t, errAny.Error())
-		assert.Equal(t, nilObject, got)
+		got, err := finder.Exists(ctx, One, shard, id)
+		require.NoError(t, err)
+		require.True(t, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
 	})
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("GetOneLocally", func(t *testing.T) {
 		var (
 			f      = newFakeFactory("C1", shard, nodes)
-			finder = f.newFinder(nodes[2])
 			item   = objects.Replica{ID: id, Object: object(id, 3)}
+			local  = &fakeLocalReader{object: item}
+			finder = f.newFinder("A", WithLocalReader(local))
 		)
-		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item, nil)
 		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
-		assert.Nil(t, err)
-		assert.Equal(t, item.Object, got)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, cls, shard, id, proj, adds)
 	})
 
-	t.Run("NotFound", func(t *testing.T) {
+	t.Run("GetOneProjected", func(t *testing.T) {
 		var (
 			f      = newFakeFactory("C1", shard, nodes)
-			finder = f.newFinder("A")
+			item   = objects.Replica{ID: id, Object: object(id, 3)}
+			local  = &fakeLocalReader{object: item}
+			finder = f.newFinder("A", WithLocalReader(local))
+			props  = search.SelectProperties{{Name: "name"}}
+			remote = objects.Replica{ID: id, Object: object(id, 4)}
 		)
-		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(emptyItem, nil)
+		f.RClient.On("FetchObject", anyVal, anyVal, cls, shard, id, anyVal, anyVal).Return(remote, nil)
+		for _, tc := range []struct {
+			name  string
+			props search.SelectProperties
+			adds  additional.Properties
+			opts  []ReadOption
+		}{
+			{"Properties", props, adds, nil},
+			{"Vector", proj, additional.Properties{Vector: true}, nil},
+			{"MetadataOnly", proj, adds, []ReadOption{WithMetadataOnly()}},
+		} {
+			got, err := finder.GetOne(ctx, One, shard, id, tc.props, tc.adds, tc.opts...)
+			require.NoError(t, err, tc.name)
+			require.Equal(t, remote.Object, got, tc.name)
+		}
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 3)
+	})
+
+	t.Run("NotAReplica", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes[1:])
+			local   = &fakeLocalReader{digests: []RepairResponse{{ID: id.String(), UpdateTime: 3}}}
+			finder  = f.newFinder("A", WithLocalReader(local))
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+		}
+		got, err := finder.Exists(ctx, One, shard, id)
+		require.NoError(t, err)
+		require.True(t, got)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+}
+
+type fakeLatencyObserver struct {
+	sync.Mutex
+	ops map[string][]string // host -> operations
+}
+
+func (o *fakeLatencyObserver) ObserveLatency(host, op string, d time.Duration) {
+	o.Lock()
+	defer o.Unlock()
+	if o.ops == nil {
+		o.ops = make(map[string][]string)
+	}
+	o.ops[host] = append(o.ops[host], op)
+}
+
+func TestFinderLatencyObserver(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		observer  = &fakeLatencyObserver{}
+		f         = newFakeFactory("C1", shard, nodes)
+		finder    = f.newFinder("A", WithLatencyObserver(observer))
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+	f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+	f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR, nil)
+
+	got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+	require.NoError(t, err)
+	require.Equal(t, item.Object, got)
+
+	observer.Lock()
+	defer observer.Unlock()
+	assert.Equal(t, map[string][]string{
+		"A": {"FetchObject"},
+		"B": {"DigestObjects"},
+		"C": {"DigestObjects"},
+	}, observer.ops)
+}
+
+// fakeNodeHealth reports the nodes it holds as unhealthy
+type fakeNodeHealth map[string]bool
+
+func (h fakeNodeHealth) Healthy(node string) bool { return !h[node] }
+
+func TestFinderNodeHealth(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		health    = fakeNodeHealth{"A": true}
+		digestIDs = []strfmt.UUID{id}
+		item      = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("HealthyDirectRead", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A", WithNodeHealth(health))
+		for _, n := range nodes {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
 
 		got, err := finder.GetOne(ctx, One, shard, id, proj, adds)
-		assert.Nil(t, err)
-		assert.Equal(t, nilObject, got)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		// the local replica is unhealthy, a healthy one serves the read
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+	})
+
+	t.Run("UnhealthyNeededForLevel", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A", WithNodeHealth(health))
+		for _, n := range nodes {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+		}
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds)
+		f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[0], cls, shard, digestIDs)
 	})
 }
 
@@ -525,6 +2320,47 @@ func TestFinderExistsWithConsistencyLevelALL(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, false, got)
 	})
+
+	t.Run("DigestOnly", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+		)
+		// replicas diverge, B holds the most recent version and C lacks the object
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 2}}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 3}}, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).
+			Return([]RepairResponse{{ID: id.String()}}, nil)
+
+		got, err := finder.Exists(ctx, All, shard, id, WithExistsMode(ExistsDigestOnly))
+		assert.Nil(t, err)
+		assert.Equal(t, true, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, anyVal, anyVal, anyVal, anyVal, anyVal)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("DigestOnlyDeleted", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		)
+		// the most recent version is a deletion
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 3, Deleted: true}}, nil)
+
+		got, err := finder.Exists(ctx, All, shard, id, WithExistsMode(ExistsDigestOnly))
+		assert.Nil(t, err)
+		assert.Equal(t, false, got)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, anyVal, anyVal, anyVal, anyVal, anyVal)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
 }
 
 func TestFinderExistsWithConsistencyLevelQuorum(t *testing.T) {
@@ -626,6 +2462,139 @@ func TestFinderExistsWithConsistencyLevelOne(t *testing.T) {
 	})
 }
 
+func TestFinderExistsVerified(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		ctx       = context.Background()
+		proj      = search.SelectProperties{}
+		adds      = additional.Properties{}
+		digestIDs = []strfmt.UUID{id}
+		digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("Served", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		item := objects.Replica{ID: id, Object: object(id, 3)}
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
+		exists, err := finder.ExistsVerified(ctx, All, shard, id)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+	})
+
+	t.Run("ServedBySecondReplica", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		item := objects.Replica{ID: id, Object: object(id, 3)}
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+		}
+		peer := mock.MatchedBy(func(string) bool { return true })
+		f.RClient.On("FetchObject", anyVal, peer, cls, shard, id, proj, adds).Return(objects.Replica{}, errAny).Once()
+		f.RClient.On("FetchObject", anyVal, peer, cls, shard, id, proj, adds).Return(item, nil).Once()
+		exists, err := finder.ExistsVerified(ctx, All, shard, id)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 2)
+	})
+
+	t.Run("DigestContentDivergence", func(t *testing.T) {
+		f := newFakeFactory("C1", shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(objects.Replica{}, errAny)
+		}
+		exists, err := finder.ExistsVerified(ctx, All, shard, id)
+		assert.Nil(t, err)
+		assert.False(t, exists)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 3)
+
+		// plain Exists trusts the digests
+		exists, err = finder.Exists(ctx, All, shard, id)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+	})
+}
+
+func TestFinderExistsOrGet(t *testing.T) {
+	var (
+		id    = strfmt.UUID("123")
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		proj  = search.SelectProperties{}
+		adds  = additional.Properties{}
+	)
+
+	t.Run("Exists", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
+
+		exists, got, err := finder.ExistsOrGet(ctx, Quorum, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, item.Object, got)
+		// a single digest round at quorum and a single fetch
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 2)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 0}}
+		)
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+		}
+
+		exists, got, err := finder.ExistsOrGet(ctx, Quorum, shard, id, proj, adds)
+		assert.Nil(t, err)
+		assert.False(t, exists)
+		assert.Nil(t, got)
+		f.RClient.AssertNumberOfCalls(t, "DigestObjects", 2)
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, anyVal, cls, shard, id, proj, adds)
+	})
+
+	t.Run("ObjectChanged", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 4)}
+			digestR   = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		for _, n := range nodes {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR, nil)
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, id, proj, adds).Return(item, nil)
+		}
+
+		_, got, err := finder.ExistsOrGet(ctx, Quorum, shard, id, proj, adds)
+		assert.ErrorIs(t, err, errConflictObjectChanged)
+		assert.Nil(t, got)
+	})
+}
+
 func TestFinderCheckConsistencyALL(t *testing.T) {
 	var (
 		ids    = []strfmt.UUID{"0", "1", "2", "3", "4", "5"}
@@ -652,6 +2621,40 @@ func TestFinderCheckConsistencyALL(t *testing.T) {
 		f.assertLogErrorContains(t, errRead.Error())
 	})
 
+	t.Run("RetryBudget", func(t *testing.T) {
+		var (
+			shard       = shards[0]
+			f           = newFakeFactory("C1", shard, nodes)
+			finder      = f.newFinder("A")
+			xs, digestR = genInputs("A", shard, 1, ids)
+		)
+		var (
+			calls   atomic.Int32
+			retried = make(chan struct{})
+			release = make(chan struct{})
+		)
+		count := func(mock.Arguments) {
+			if calls.Add(1) == 3 { // the only retry the budget allows
+				close(retried)
+				<-release
+			}
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, errAny).Run(count)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, errAny).Run(count)
+
+		err := finder.CheckConsistency(ctx, All, xs, WithRetryBudget(1))
+		assert.ErrorIs(t, err, errRead)
+		// B and C are called once and only one of them is retried,
+		// the other one is refused a retry while the first is pending
+		select {
+		case <-retried:
+		case <-time.After(5 * time.Second):
+			t.Fatal("no replica was retried")
+		}
+		assert.Equal(t, int32(3), calls.Load())
+		close(release)
+	})
+
 	t.Run("OneShard", func(t *testing.T) {
 		var (
 			shard       = shards[0]
@@ -668,6 +2671,27 @@ func TestFinderCheckConsistencyALL(t *testing.T) {
 		assert.ElementsMatch(t, want, xs)
 	})
 
+	t.Run("DuplicateIDs", func(t *testing.T) {
+		var (
+			shard       = shards[0]
+			f           = newFakeFactory("C1", shard, nodes)
+			finder      = f.newFinder("A")
+			xs, digestR = genInputs("A", shard, 2, ids)
+			dup, _      = genInputs("A", shard, 2, ids[1:2])
+		)
+		// each id is requested once
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, nil)
+
+		xs = append(xs, dup[0])
+		err := finder.CheckConsistency(ctx, All, xs)
+		assert.Nil(t, err)
+		assert.Same(t, xs[1], xs[len(xs)-1])
+		for _, x := range xs {
+			assert.True(t, x.IsConsistent)
+		}
+	})
+
 	t.Run("TwoShards", func(t *testing.T) {
 		var (
 			f             = newFakeFactory("C1", shards[0], nodes)
@@ -859,6 +2883,37 @@ func TestFinderCheckConsistencyQuorum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, want, xs)
 	})
+
+	t.Run("MaxFanOut", func(t *testing.T) {
+		var (
+			nodes   = []string{"A", "B", "C", "D", "E"}
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A")
+			xs      = []*storobj.Object{objectEx(ids[0], 1, shard, "A"), objectEx(ids[1], 2, shard, "A"), objectEx(ids[2], 3, shard, "A")}
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 1},
+				{ID: ids[1].String(), UpdateTime: 2},
+				{ID: ids[2].String(), UpdateTime: 3},
+			}
+			mu      sync.Mutex
+			queried = map[string]bool{}
+			record  = func(a mock.Arguments) {
+				mu.Lock()
+				defer mu.Unlock()
+				queried[a[1].(string)] = true
+			}
+		)
+		// the first digest read fails, its replica is retried rather than a fourth one queried
+		f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids).Return(digestR, errAny).Once().Run(record)
+		f.RClient.On("DigestObjects", anyVal, anyVal, cls, shard, ids).Return(digestR, nil).Run(record)
+
+		err := finder.CheckConsistency(ctx, Quorum, xs, WithMaxFanOut(3))
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, setObjectsConsistency(xs, true), xs)
+		// quorum of five: the direct read and two digest peers
+		assert.Len(t, queried, 2)
+		assert.NotContains(t, queried, "A")
+	})
 }
 
 func TestFinderCheckConsistencyOne(t *testing.T) {
@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ReadTrace records the decisions taken by a read for debugging purposes
+type ReadTrace struct {
+	mu sync.Mutex
+	// Replicas holds the response of each replica in order of arrival
+	Replicas []ReplicaTrace
+	// Winner is the replica whose version was chosen, empty if none was chosen
+	Winner           string
+	WinnerUpdateTime int64
+	// Repairs holds the overwrites issued to stale replicas
+	Repairs []RepairTrace
+	// Result is the object returned by the read
+	Result *storobj.Object
+	Err    string
+}
+
+// ReplicaTrace is the response of a single replica
+type ReplicaTrace struct {
+	Node       string
+	UpdateTime int64
+	Deleted    bool
+	Digest     bool // false for the replica which sent the full object
+}
+
+// RepairTrace is an overwrite sent to a stale replica
+type RepairTrace struct {
+	Node            string
+	StaleUpdateTime int64
+	UpdateTime      int64
+	Deleted         bool
+	Err             string
+}
+
+// GetOneTraced works like GetOne but also returns a trace of every replica's
+// response, the chosen version and the repairs issued.
+// It is meant for debugging and costs more than GetOne.
+func (f *Finder) GetOneTraced(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
+	opts ...ReadOption,
+) (*storobj.Object, *ReadTrace, error) {
+	trace := &ReadTrace{}
+	obj, err := f.GetOne(ctx, l, shard, id, props, adds, append(opts, withTrace(trace))...)
+	trace.Result = obj
+	if err != nil {
+		trace.Err = err.Error()
+	}
+	return obj, trace, err
+}
+
+// GetOneWithDigests works like GetOne but also returns the version reported by
+// each replica queried, by node name, for the object as a RepairResponse.
+// The version of the replica which sent the full object is derived from it.
+// It is meant for debugging and, unlike GetOne, never reads from the local shard directly.
+func (f *Finder) GetOneWithDigests(ctx context.Context,
+	l ConsistencyLevel, shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
+	opts ...ReadOption,
+) (*storobj.Object, map[string]RepairResponse, error) {
+	digests := map[string]RepairResponse{}
+	obj, err := f.GetOne(ctx, l, shard, id, props, adds, append(opts, withDigests(&digests))...)
+	return obj, digests, err
+}
+
+func withDigests(digests *map[string]RepairResponse) ReadOption {
+	return func(o *readOptions) {
+		o.digests = digests
+		o.digestSet = &replicaDigests{m: map[string]RepairResponse{}}
+	}
+}
+
+// replicaDigests collects the response of each replica by host.
+// It is safe for concurrent use, a nil set ignores responses.
+type replicaDigests struct {
+	mu sync.Mutex
+	m  map[string]RepairResponse
+}
+
+func (d *replicaDigests) add(host string, x RepairResponse) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.m[host] = x
+}
+
+// byNode returns a copy of the responses keyed by node name
+func (d *replicaDigests) byNode(st rState) map[string]RepairResponse {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	m := make(map[string]RepairResponse, len(d.m))
+	for host, x := range d.m {
+		m[st.nodeName(host)] = x
+	}
+	return m
+}
+
+func withTrace(trace *ReadTrace) ReadOption {
+	return func(o *readOptions) {
+		o.trace = trace
+	}
+}
+
+func (t *ReadTrace) addReplica(x ReplicaTrace) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Replicas = append(t.Replicas, x)
+}
+
+func (t *ReadTrace) setWinner(node string, uTime int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Winner, t.WinnerUpdateTime = node, uTime
+}
+
+func (t *ReadTrace) addRepair(x RepairTrace) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Repairs = append(t.Repairs, x)
+}
+
+func newRepairTrace(node string, x *objects.VObject, resp []RepairResponse, err error) RepairTrace {
+	t := RepairTrace{
+		Node:            node,
+		StaleUpdateTime: x.StaleUpdateTime,
+		UpdateTime:      x.LastUpdateTimeUnixMilli,
+		Deleted:         x.Deleted,
+	}
+	if err != nil {
+		t.Err = err.Error()
+	} else if len(resp) > 0 {
+		t.Err = resp[0].Err
+	}
+	return t
+}
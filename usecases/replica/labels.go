@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type labelsKey struct{}
+
+// ContextWithLabels returns a copy of ctx carrying labels, e.g. the feature a read
+// originates from, so that reads can be attributed to workloads. The Finder adds them
+// to the fields of the logs written for reads made with the returned context and to
+// the audit events they produce, and passes them to a LatencyObserver implementing
+// LabeledLatencyObserver. Labels already carried by ctx are kept unless overridden.
+func ContextWithLabels(ctx context.Context, labels map[string]string) context.Context {
+	if len(labels) == 0 {
+		return ctx
+	}
+	prev := labelsFrom(ctx)
+	m := make(map[string]string, len(prev)+len(labels))
+	for k, v := range prev {
+		m[k] = v
+	}
+	for k, v := range labels {
+		m[k] = v
+	}
+	return context.WithValue(ctx, labelsKey{}, m)
+}
+
+// labelsFrom returns the labels carried by ctx, nil if there are none
+func labelsFrom(ctx context.Context) map[string]string {
+	m, _ := ctx.Value(labelsKey{}).(map[string]string)
+	return m
+}
+
+// labeled returns l with the labels carried by ctx as fields
+func labeled(ctx context.Context, l logrus.FieldLogger) logrus.FieldLogger {
+	m := labelsFrom(ctx)
+	if len(m) == 0 {
+		return l
+	}
+	fields := make(logrus.Fields, len(m))
+	for k, v := range m {
+		fields[k] = v
+	}
+	return l.WithFields(fields)
+}
+
+// LabeledLatencyObserver is a LatencyObserver which also receives
+// the labels of the read a request has been sent for, see ContextWithLabels
+type LabeledLatencyObserver interface {
+	LatencyObserver
+	// ObserveLabeledLatency records the duration d of operation op
+	// sent to host for a read labeled with labels
+	ObserveLabeledLatency(host, op string, d time.Duration, labels map[string]string)
+}
@@ -73,6 +73,11 @@ func (f *fakeRClient) DigestObjectsInTokenRange(ctx context.Context, host, index
 	return args.Get(0).([]RepairResponse), args.Get(1).(uint64), args.Error(2)
 }
 
+func (f *fakeRClient) ShardReplicas(ctx context.Context, host, index, shard string) ([]string, error) {
+	args := f.Called(ctx, host, index, shard)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (f *fakeRClient) HashTreeLevel(ctx context.Context,
 	host, index, shard string, level int, discriminant *hashtree.Bitset,
 ) (digests []hashtree.Digest, err error) {
@@ -30,6 +30,8 @@ type RemoteIncomingRepo interface {
 type RemoteIncomingSchema interface {
 	// WaitForUpdate ensures that the local schema has caught up to schemaVersion
 	WaitForUpdate(ctx context.Context, schemaVersion uint64) error
+	// ShardReplicas returns the nodes replicating shard according to the local schema
+	ShardReplicas(class, shard string) ([]string, error)
 }
 
 type RemoteIndexIncomingRepo interface {
@@ -185,6 +187,13 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 	return index.DigestObjects(ctx, shardName, ids)
 }
 
+// ShardReplicas returns the nodes which this node believes replicate shard
+func (rri *RemoteReplicaIncoming) ShardReplicas(ctx context.Context,
+	indexName, shardName string,
+) ([]string, error) {
+	return rri.schema.ShardReplicas(indexName, shardName)
+}
+
 func (rri *RemoteReplicaIncoming) indexForIncomingRead(ctx context.Context, indexName string) (RemoteIndexIncomingRepo, *SimpleResponse) {
 	index := rri.repo.GetIndexForIncomingReplica(schema.ClassName(indexName))
 	if index == nil {
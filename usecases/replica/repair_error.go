@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// errInconsistent replicas still hold different versions of an object after repair
+var errInconsistent = errors.New("replicas hold different versions")
+
+// RepairFailure is an object which could not be made consistent
+type RepairFailure struct {
+	ID  strfmt.UUID
+	Err error
+}
+
+// NodeRepair is the outcome of the overwrites sent to a single node
+type NodeRepair struct {
+	Node      string
+	Succeeded []strfmt.UUID   // sorted by id
+	Failed    []RepairFailure // sorted by id
+}
+
+// RepairError lists every object CheckConsistency could not make consistent
+// together with its cause, so that callers can retry exactly those objects.
+// It is only returned if the Finder has been created WithRepairErrors.
+type RepairError struct {
+	Failures []RepairFailure // sorted by id
+	// Nodes breaks the overwrites down by node, sorted by node name, so that
+	// remediation can target the nodes which failed only
+	Nodes []NodeRepair
+}
+
+func (e *RepairError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %d objects could not be repaired", errRepair, len(e.Failures))
+	for i, x := range e.Failures {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		fmt.Fprintf(&sb, "%s: %v", x.ID, x.Err)
+	}
+	return sb.String()
+}
+
+func (e *RepairError) Unwrap() error { return errRepair }
+
+// IDs returns the ids of the objects which could not be repaired
+func (e *RepairError) IDs() []strfmt.UUID {
+	ids := make([]strfmt.UUID, len(e.Failures))
+	for i, x := range e.Failures {
+		ids[i] = x.ID
+	}
+	return ids
+}
+
+// FailedNodes returns the names of the nodes which failed at least one overwrite
+func (e *RepairError) FailedNodes() []string {
+	var nodes []string
+	for _, x := range e.Nodes {
+		if len(x.Failed) > 0 {
+			nodes = append(nodes, x.Node)
+		}
+	}
+	return nodes
+}
+
+// merge returns e extended with the failures of x, e may be nil
+func (e *RepairError) merge(x *RepairError) *RepairError {
+	if e == nil {
+		return x
+	}
+	e.Failures = append(e.Failures, x.Failures...)
+	sort.Slice(e.Failures, func(i, j int) bool { return e.Failures[i].ID < e.Failures[j].ID })
+	for _, y := range x.Nodes {
+		i := slices.IndexFunc(e.Nodes, func(n NodeRepair) bool { return n.Node == y.Node })
+		if i < 0 {
+			e.Nodes = append(e.Nodes, y)
+			continue
+		}
+		n := &e.Nodes[i]
+		n.Succeeded = append(n.Succeeded, y.Succeeded...)
+		slices.Sort(n.Succeeded)
+		n.Failed = append(n.Failed, y.Failed...)
+		sort.Slice(n.Failed, func(i, j int) bool { return n.Failed[i].ID < n.Failed[j].ID })
+	}
+	sort.Slice(e.Nodes, func(i, j int) bool { return e.Nodes[i].Node < e.Nodes[j].Node })
+	return e
+}
+
+// repairFailures collects the causes of failed repairs, safe for concurrent use
+type repairFailures struct {
+	mu    sync.Mutex
+	m     map[strfmt.UUID]error
+	nodes map[string]*NodeRepair
+}
+
+// addOverwrite records the outcome of the overwrite of id on node, err being
+// nil if it succeeded. It is a no-op on a nil receiver.
+func (r *repairFailures) addOverwrite(node string, id strfmt.UUID, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.nodes == nil {
+		r.nodes = make(map[string]*NodeRepair)
+	}
+	x := r.nodes[node]
+	if x == nil {
+		x = &NodeRepair{Node: node}
+		r.nodes[node] = x
+	}
+	if err != nil {
+		x.Failed = append(x.Failed, RepairFailure{ID: id, Err: err})
+	} else {
+		x.Succeeded = append(x.Succeeded, id)
+	}
+}
+
+// add records err as the cause of the failed repair of id, unless a cause
+// has already been recorded. It is a no-op on a nil receiver.
+func (r *repairFailures) add(id strfmt.UUID, err error) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.m[id]; !ok {
+		r.m[id] = err
+	}
+}
+
+// err returns a RepairError listing all failures, or nil if there are none
+func (r *repairFailures) err() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.m) == 0 {
+		return nil
+	}
+	xs := make([]RepairFailure, 0, len(r.m))
+	for id, err := range r.m {
+		xs = append(xs, RepairFailure{ID: id, Err: err})
+	}
+	sort.Slice(xs, func(i, j int) bool { return xs[i].ID < xs[j].ID })
+
+	nodes := make([]NodeRepair, 0, len(r.nodes))
+	for _, x := range r.nodes {
+		y := *x
+		y.Succeeded = slices.Clone(x.Succeeded)
+		slices.Sort(y.Succeeded)
+		y.Failed = slices.Clone(x.Failed)
+		sort.Slice(y.Failed, func(i, j int) bool { return y.Failed[i].ID < y.Failed[j].ID })
+		nodes = append(nodes, y)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return &RepairError{Failures: xs, Nodes: nodes}
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"container/list"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// errRepairDeferred the repair byte limit of the shard has been reached for the current interval
+var errRepairDeferred = errors.New("repair deferred: shard repair byte limit reached")
+
+// repairLimiter caps the number of bytes of overwrites sent per shard and per interval
+type repairLimiter struct {
+	maxBytes   int64
+	interval   time.Duration
+	onDeferred func(shard string, bytes int64)
+
+	mu      sync.Mutex
+	windows map[string]*repairWindow // shard names
+}
+
+type repairWindow struct {
+	start time.Time
+	used  int64
+}
+
+// allow reports whether n more bytes of overwrites may be sent to shard.
+// Overwrites are allowed until the limit has been reached, so the last
+// allowed one may exceed it.
+func (l *repairLimiter) allow(shard string, n int64) bool {
+	if l == nil {
+		return true
+	}
+	now := time.Now()
+	l.mu.Lock()
+	w := l.windows[shard]
+	if w == nil || now.Sub(w.start) >= l.interval {
+		w = &repairWindow{start: now}
+		l.windows[shard] = w
+	}
+	ok := w.used < l.maxBytes
+	if ok {
+		w.used += n
+	}
+	l.mu.Unlock()
+	if !ok && l.onDeferred != nil {
+		l.onDeferred(shard, n)
+	}
+	return ok
+}
+
+// splitBySize sorts the overwrites of x by serialized size, smallest first, keeping the
+// order of equal sizes, and splits them into parts of at most maxBytes. An overwrite
+// larger than maxBytes makes up a part on its own.
+func splitBySize(x batchRepair, maxBytes int64) []batchRepair {
+	sizes := make(map[*objects.VObject]int64, len(x.query))
+	for _, y := range x.query {
+		sizes[y] = payloadSize([]*objects.VObject{y})
+	}
+	query := slices.Clone(x.query)
+	sort.SliceStable(query, func(i, j int) bool { return sizes[query[i]] < sizes[query[j]] })
+
+	var (
+		parts []batchRepair
+		n     int64 // bytes of the last part
+	)
+	for _, y := range query {
+		if len(parts) == 0 || n > 0 && n+sizes[y] > maxBytes {
+			parts = append(parts, batchRepair{receiver: x.receiver, rid: x.rid, m: make(map[string]int)})
+			n = 0
+		}
+		p := &parts[len(parts)-1]
+		p.query = append(p.query, y)
+		p.m[string(y.ID)] = x.m[string(y.ID)]
+		n += sizes[y]
+	}
+	return parts
+}
+
+// payloadSize returns the size of xs as sent over the wire
+func payloadSize(xs []*objects.VObject) int64 {
+	var n int64
+	for _, x := range xs {
+		if b, err := x.MarshalBinary(); err == nil {
+			n += int64(len(b))
+		}
+	}
+	return n
+}
+
+// recentReads is a bounded set of the ids most recently read, least recently read evicted first
+type recentReads struct {
+	size int
+
+	mu    sync.Mutex
+	order *list.List                    // ids, most recent first
+	elems map[strfmt.UUID]*list.Element // ids
+}
+
+func newRecentReads(size int) *recentReads {
+	return &recentReads{
+		size:  size,
+		order: list.New(),
+		elems: make(map[strfmt.UUID]*list.Element, size),
+	}
+}
+
+// touch records a read of id
+func (s *recentReads) touch(id strfmt.UUID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.elems[id]; ok {
+		s.order.MoveToFront(e)
+		return
+	}
+	s.elems[id] = s.order.PushFront(id)
+	if s.order.Len() > s.size {
+		e := s.order.Back()
+		s.order.Remove(e)
+		delete(s.elems, e.Value.(strfmt.UUID))
+	}
+}
+
+// contains reports whether id has been read recently
+func (s *recentReads) contains(id strfmt.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.elems[id]
+	return ok
+}
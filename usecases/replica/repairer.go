@@ -12,10 +12,16 @@
 package replica
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -39,6 +45,15 @@ var (
 
 	// errConflictObjectChanged object changed since last time and cannot be repaired
 	errConflictObjectChanged = errors.New("source object changed during repair")
+
+	// errClockSkew the most recent version is suspiciously far ahead of the other ones
+	errClockSkew = errors.New("suspect update time ahead of other replicas")
+
+	// errUnconfirmed the most recent version could not be confirmed by a second replica
+	errUnconfirmed = errors.New("repair source not confirmed")
+
+	// errVectorDimension replicas hold vectors of different dimensions for the same object
+	errVectorDimension = errors.New("conflict: vector dimensions differ across replicas")
 )
 
 // repairer tries to detect inconsistencies and repair objects when reading them from replicas
@@ -47,6 +62,61 @@ type repairer struct {
 	deletionStrategy string
 	client           finderClient // needed to commit and abort operation
 	logger           logrus.FieldLogger
+	// acceptNewerTarget considers a replica already holding a newer version
+	// than the one pushed to it as repaired
+	acceptNewerTarget bool
+	// comparator decides which of two versions is fresher, nil compares update times
+	comparator func(a, b RepairResponse) int
+	// auditCh receives audit events, nil disables auditing
+	auditCh chan<- AuditEvent
+	// breaker flags lagging replicas as degraded, nil disables it
+	breaker *stalenessBreaker
+	// stalest tracks how often each replica is the stalest, nil disables it
+	stalest *stalestTracker
+	// partialRepair tolerates failed overwrites as long as enough
+	// replicas hold the most recent version to satisfy the consistency level
+	partialRepair bool
+	// vectorNodes maps shards to the node preferred for reads returning vectors
+	vectorNodes map[string]string
+	// repairErrors makes CheckConsistency report objects which could not be
+	// repaired with a RepairError
+	repairErrors bool
+	// skewTolerance, if positive, is how far (in milliseconds) the most recent
+	// version may be ahead of the next most recent one before being suspected
+	// to come from a node with a skewed clock
+	skewTolerance int64
+	// onSkew is notified of suspect versions, it may be nil
+	onSkew func(node string, ahead time.Duration)
+	// fetchRetries is the number of other replicas holding the most recent
+	// version tried when fetching it from the winner fails
+	fetchRetries int
+	// maxRequestBytes, if positive, splits the overwrites repairing a replica
+	// into requests of at most this many bytes, sent smallest first
+	maxRequestBytes int64
+	// onChanged is notified of objects whose direct read was stale, it may be nil
+	onChanged func(shard string, ids []strfmt.UUID)
+	// recent holds the ids recently read by GetOne, whose overwrites are sent
+	// before the others. nil disables prioritization
+	recent *recentReads
+	// checkDimensions refuses to repair objects whose replicas hold vectors of different dimensions
+	checkDimensions bool
+	// onDimensionConflict is notified of objects refused by checkDimensions, it may be nil
+	onDimensionConflict func(shard string, id strfmt.UUID, vector string)
+	// missingOnly restricts repairs to replicas which hold neither the object nor its tombstone
+	missingOnly bool
+	// maxRefetch, if positive, is the maximum number of objects
+	// fetched at once by the repair of a batch
+	maxRefetch int
+	// respectTombstones considers an object deleted if a replica rejects its overwrite
+	// because it deleted the object after the pushed version
+	respectTombstones bool
+}
+
+// notifyChanged passes the ids of objects whose direct read was stale to r.onChanged
+func (r *repairer) notifyChanged(shard string, ids ...strfmt.UUID) {
+	if r.onChanged != nil && len(ids) > 0 {
+		r.onChanged(shard, ids)
+	}
 }
 
 // repairOne repairs a single object (used by Finder::GetOne)
@@ -55,6 +125,7 @@ func (r *repairer) repairOne(ctx context.Context,
 	id strfmt.UUID,
 	votes []objTuple, st rState,
 	contentIdx int,
+	o readOptions,
 ) (_ *storobj.Object, err error) {
 	var (
 		deleted      bool
@@ -71,16 +142,34 @@ func (r *repairer) repairOne(ctx context.Context,
 				deletionTime = x.UTime
 			}
 		}
-		if x.UTime > lastUTime {
-			lastUTime = x.UTime
+		if r.compare(x.digest(id), votes[winnerIdx].digest(id)) > 0 {
 			winnerIdx = i
 		}
 	}
+	lastUTime = votes[winnerIdx].UTime
+	o.freshest.set(id, lastUTime)
+	if r.stalest != nil {
+		hosts, lags := make([]string, len(votes)), make([]int64, len(votes))
+		for i, x := range votes {
+			hosts[i], lags[i] = x.sender, lastUTime-x.UTime
+		}
+		r.stalest.observe(st, stalest(hosts, lags))
+	}
+	if r.skewTolerance > 0 {
+		uTimes := make([]int64, len(votes))
+		for i, x := range votes {
+			uTimes[i] = x.UTime
+		}
+		if err := r.checkSkew(ctx, st, votes[winnerIdx].sender, uTimes[winnerIdx], uTimes); err != nil {
+			return nil, err
+		}
+	}
 
 	if deleted && r.deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict {
 		gr := enterrors.NewErrorGroupWrapper(r.logger)
 		for _, vote := range votes {
-			if vote.o.Deleted && vote.UTime == deletionTime {
+			if vote.o.Deleted && vote.UTime == deletionTime || o.skipRepair(st, vote.sender) ||
+				r.keepsExisting(ctx, st, shard, id, vote.sender, vote.missing()) {
 				continue
 			}
 
@@ -94,6 +183,11 @@ func (r *repairer) repairOne(ctx context.Context,
 					StaleUpdateTime:         vote.UTime,
 				}}
 				resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
+				o.trace.addRepair(newRepairTrace(vote.sender, ups[0], resp, err))
+				r.auditRepairs(ctx, st, shard, vote.sender, ups, resp, err)
+				if errors.Is(err, errRepairDeferred) {
+					return nil // left to a later repair
+				}
 				if err != nil {
 					return fmt.Errorf("node %q could not repair deleted object: %w", vote.sender, err)
 				}
@@ -112,23 +206,59 @@ func (r *repairer) repairOne(ctx context.Context,
 	}
 
 	// fetch most recent object
-	updates := votes[contentIdx].o
+	var updates objects.Replica
+	// a metadata-only direct read cannot be used to overwrite stale replicas
+	if contentIdx >= 0 && (!o.metadataOnly || allAt(votes, lastUTime)) {
+		updates = votes[contentIdx].o
+	}
 	winner := votes[winnerIdx]
+	o.trace.setWinner(winner.sender, lastUTime)
 
-	if updates.UpdateTime() != lastUTime {
-		updates, err = cl.FullRead(ctx, winner.sender, r.class, shard, id,
-			search.SelectProperties{}, additional.Properties{}, 9)
+	if winner.o.Deleted {
+		// the most recent version is a tombstone, its digest is all there is to propagate
+		updates = objects.Replica{ID: id, Deleted: true, LastUpdateTimeUnixMilli: lastUTime}
+	} else if contentIdx < 0 || updates.UpdateTime() != lastUTime {
+		updates, err = r.fetchLatest(ctx, shard, id, votes, winnerIdx)
 		if err != nil {
 			return nil, fmt.Errorf("get most recent object from %s: %w", winner.sender, err)
 		}
 		if updates.UpdateTime() != lastUTime {
+			if o.olderFallback {
+				return r.repairOlder(ctx, shard, id, votes, st, contentIdx, winner, o)
+			}
 			return nil, fmt.Errorf("fetch new state from %s: %w, %v", winner.sender, errConflictObjectChanged, err)
 		}
+		if o.scopedRepair && contentIdx >= 0 && o.sameRequestedContent(votes[contentIdx].o, updates) {
+			// the difference does not concern the caller
+			return votes[contentIdx].o.Object, nil
+		}
 	}
 
-	gr := enterrors.NewErrorGroupWrapper(r.logger)
+	if contentIdx >= 0 && !updates.Deleted {
+		if err := r.dimensionsAgree(ctx, shard, id, votes[contentIdx].o.Object, updates.Object); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		gr       = enterrors.NewErrorGroupWrapper(r.logger)
+		repaired atomic.Int32 // number of replicas successfully repaired
+		upToDate int          // number of replicas already holding the most recent version
+
+		mu        sync.Mutex
+		holders   []string // replicas holding the most recent version once repaired
+		deletedAt int64    // a replica deleted the object after the most recent version at this time
+	)
 	for _, vote := range votes { // repair
 		if vote.UTime == lastUTime {
+			upToDate++
+			mu.Lock()
+			holders = append(holders, vote.sender)
+			mu.Unlock()
+			continue
+		}
+		if o.skipRepair(st, vote.sender) || o.keepsLocal(vote.sender, lastUTime) ||
+			r.keepsExisting(ctx, st, shard, id, vote.sender, vote.missing()) {
 			continue
 		}
 
@@ -160,17 +290,185 @@ func (r *repairer) repairOne(ctx context.Context,
 				StaleUpdateTime:         vote.UTime,
 			}}
 			resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
+			o.trace.addRepair(newRepairTrace(vote.sender, ups[0], resp, err))
+			r.auditRepairs(ctx, st, shard, vote.sender, ups, resp, err)
+			if errors.Is(err, errRepairDeferred) {
+				return nil // left to a later repair
+			}
 			if err != nil {
 				return fmt.Errorf("node %q could not repair object: %w", vote.sender, err)
 			}
-			if len(resp) > 0 && resp[0].Err != "" {
+			if len(resp) > 0 && r.deletedSince(ctx, vote.sender, shard, updates, resp[0]) {
+				mu.Lock()
+				deletedAt = max(deletedAt, resp[0].UpdateTime)
+				mu.Unlock()
+				return nil
+			}
+			if len(resp) > 0 && resp[0].Err != "" && !r.converged(ctx, vote.sender, shard, updates, resp[0]) {
 				return fmt.Errorf("overwrite %w %s: %s", errConflictObjectChanged, vote.sender, resp[0].Err)
 			}
+			repaired.Add(1)
+			mu.Lock()
+			holders = append(holders, vote.sender)
+			mu.Unlock()
 			return nil
 		})
 	}
 
-	return updates.Object, gr.Wait()
+	err = gr.Wait()
+	if err == nil && deletedAt != 0 {
+		r.propagateTombstone(ctx, st, shard, id, holders, lastUTime, deletedAt)
+		return nil, nil
+	}
+	if err != nil && r.partialRepair {
+		if level, _ := st.ConsistencyLevel(st.CLevel); upToDate+int(repaired.Load()) >= level {
+			labeled(ctx, r.logger).WithField("op", "repair_one").WithField("class", r.class).
+				WithField("shard", shard).WithField("uuid", id).
+				Warnf("consistency level met despite failed repair: %v", err)
+			return updates.Object, nil
+		}
+	}
+	return updates.Object, err
+}
+
+// checkSkew returns errClockSkew if the most recent version, held by winner, is more
+// than r.skewTolerance ahead of the next most recent update time in uTimes
+func (r *repairer) checkSkew(ctx context.Context, st rState, winner string, last int64, uTimes []int64) error {
+	next, found := int64(0), false
+	for _, t := range uTimes {
+		if t < last && (!found || t > next) {
+			next, found = t, true
+		}
+	}
+	if !found || last-next <= r.skewTolerance {
+		return nil
+	}
+	node, ahead := st.nodeName(winner), time.Duration(last-next)*time.Millisecond
+	labeled(ctx, r.logger).WithField("op", "repair").WithField("class", r.class).
+		WithField("node", node).WithField("ahead", ahead).
+		Warn("most recent version is suspiciously far ahead, skipping repair")
+	if r.onSkew != nil {
+		r.onSkew(node, ahead)
+	}
+	return fmt.Errorf("%w: %s is %v ahead", errClockSkew, node, ahead)
+}
+
+// keepsExisting returns true if only missing objects are repaired and the replica
+// at host, which is stale, is not missing the object with the given id.
+// The stale replica is logged instead of being repaired.
+func (r *repairer) keepsExisting(ctx context.Context, st rState, shard string, id strfmt.UUID, host string, missing bool) bool {
+	if !r.missingOnly || missing {
+		return false
+	}
+	labeled(ctx, r.logger).WithField("op", "repair").WithField("class", r.class).
+		WithField("shard", shard).WithField("uuid", id).WithField("node", st.nodeName(host)).
+		Warn("stale replica left untouched, only missing objects are repaired")
+	return true
+}
+
+// dimensionsAgree returns errVectorDimension if r.checkDimensions is set and a vector
+// is held by both a and b with different dimensions. This is not a matter of staleness
+// but e.g. of a model having been changed without reindexing all replicas.
+func (r *repairer) dimensionsAgree(ctx context.Context, shard string, id strfmt.UUID, a, b *storobj.Object) error {
+	if !r.checkDimensions {
+		return nil
+	}
+	name, da, db := dimensionConflict(a, b)
+	if da == db {
+		return nil
+	}
+	labeled(ctx, r.logger).WithField("op", "repair").WithField("class", r.class).
+		WithField("shard", shard).WithField("uuid", id).WithField("vector", name).
+		Errorf("vector dimensions differ across replicas (%d and %d), skipping repair", da, db)
+	if r.onDimensionConflict != nil {
+		r.onDimensionConflict(shard, id, name)
+	}
+	return fmt.Errorf("%w: vector %q has %d and %d dimensions", errVectorDimension, name, da, db)
+}
+
+// dimensionConflict returns the name of the first vector held by both a and b whose
+// dimensions differ along with both dimensions, "" being the unnamed vector.
+// The dimensions are equal if there is no such vector.
+func dimensionConflict(a, b *storobj.Object) (name string, da, db int) {
+	if a == nil || b == nil {
+		return "", 0, 0
+	}
+	if len(a.Vector) > 0 && len(b.Vector) > 0 && len(a.Vector) != len(b.Vector) {
+		return "", len(a.Vector), len(b.Vector)
+	}
+	for _, k := range sortedKeys(a.Vectors) {
+		u, v := a.Vectors[k], b.Vectors[k]
+		if len(u) > 0 && len(v) > 0 && len(u) != len(v) {
+			return k, len(u), len(v)
+		}
+	}
+	for _, k := range sortedKeys(a.MultiVectors) {
+		u, v := a.MultiVectors[k], b.MultiVectors[k]
+		if len(u) > 0 && len(v) > 0 && len(u[0]) != len(v[0]) {
+			return k, len(u[0]), len(v[0])
+		}
+	}
+	return "", 0, 0
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// allAt returns true if all votes are for the version updated at uTime
+func allAt(votes []objTuple, uTime int64) bool {
+	for _, v := range votes {
+		if v.UTime != uTime {
+			return false
+		}
+	}
+	return true
+}
+
+// repairOlder repairs a single object to the freshest version older than
+// the version of changed. It is used when the replicas holding the most
+// recent version changed while being repaired.
+func (r *repairer) repairOlder(ctx context.Context,
+	shard string,
+	id strfmt.UUID,
+	votes []objTuple, st rState,
+	contentIdx int,
+	changed objTuple,
+	o readOptions,
+) (*storobj.Object, error) {
+	var (
+		older    = make([]objTuple, 0, len(votes))
+		olderIdx = -1
+	)
+	for i, vote := range votes {
+		if r.compare(vote.digest(id), changed.digest(id)) >= 0 {
+			continue
+		}
+		if i == contentIdx {
+			olderIdx = len(older)
+		}
+		older = append(older, vote)
+	}
+	if len(older) == 0 {
+		return nil, fmt.Errorf("no older version to fall back to: %w", errConflictObjectChanged)
+	}
+	fallback := o
+	fallback.olderFallback = false // fall back once
+	fallback.freshest = nil        // staleness stays relative to changed
+	obj, err := r.repairOne(ctx, shard, id, older, st, olderIdx, fallback)
+	if err != nil {
+		return nil, fmt.Errorf("fall back to older version: %w", err)
+	}
+	if o.repairedToOlder != nil {
+		*o.repairedToOlder = true
+	}
+	return obj, nil
 }
 
 // iTuple tuple of indices used to identify a unique object
@@ -181,13 +479,31 @@ type iTuple struct {
 	Deleted bool
 }
 
+// freshestExist determines the existence of an object from the most recent of votes
+// as repairExist would, but without fetching the object or repairing stale replicas
+func (r *repairer) freshestExist(votes []boolTuple) existResult {
+	winnerIdx, deleted := 0, false
+	for i, x := range votes {
+		deleted = deleted || x.o.Deleted
+		if r.compare(x.o, votes[winnerIdx].o) > 0 {
+			winnerIdx = i
+		}
+	}
+	winner := votes[winnerIdx]
+	if deleted && r.deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict {
+		return existResult{Exists: false, Sender: winner.sender, UpdateTime: winner.UTime}
+	}
+	exists := !winner.o.Deleted && winner.o.UpdateTime != 0
+	return existResult{Exists: exists, Sender: winner.sender, UpdateTime: winner.UTime}
+}
+
 // repairExist repairs a single object when checking for existence
 func (r *repairer) repairExist(ctx context.Context,
 	shard string,
 	id strfmt.UUID,
 	votes []boolTuple,
 	st rState,
-) (_ bool, err error) {
+) (_ bool, latest *storobj.Object, err error) {
 	var (
 		deleted      bool
 		deletionTime int64
@@ -203,17 +519,27 @@ func (r *repairer) repairExist(ctx context.Context,
 				deletionTime = x.UTime
 			}
 		}
-		if x.UTime > lastUTime {
-			lastUTime = x.UTime
+		if r.compare(x.o, votes[winnerIdx].o) > 0 {
 			winnerIdx = i
 		}
 	}
+	lastUTime = votes[winnerIdx].UTime
+	if r.skewTolerance > 0 {
+		uTimes := make([]int64, len(votes))
+		for i, x := range votes {
+			uTimes[i] = x.UTime
+		}
+		if err := r.checkSkew(ctx, st, votes[winnerIdx].sender, uTimes[winnerIdx], uTimes); err != nil {
+			return false, nil, err
+		}
+	}
 
 	if deleted && r.deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict {
 		gr := enterrors.NewErrorGroupWrapper(r.logger)
 
 		for _, vote := range votes {
-			if vote.o.Deleted && vote.UTime == deletionTime {
+			if vote.o.Deleted && vote.UTime == deletionTime ||
+				r.keepsExisting(ctx, st, shard, id, vote.sender, vote.missing()) {
 				continue
 			}
 
@@ -227,6 +553,10 @@ func (r *repairer) repairExist(ctx context.Context,
 					StaleUpdateTime:         vote.UTime,
 				}}
 				resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
+				r.auditRepairs(ctx, st, shard, vote.sender, ups, resp, err)
+				if errors.Is(err, errRepairDeferred) {
+					return nil // left to a later repair
+				}
 				if err != nil {
 					return fmt.Errorf("node %q could not repair deleted object: %w", vote.sender, err)
 				}
@@ -237,27 +567,41 @@ func (r *repairer) repairExist(ctx context.Context,
 			})
 		}
 
-		return false, gr.Wait()
+		return false, nil, gr.Wait()
 	}
 
 	if deleted && r.deletionStrategy != models.ReplicationConfigDeletionStrategyTimeBasedResolution {
-		return false, errConflictExistOrDeleted
+		return false, nil, errConflictExistOrDeleted
 	}
 
 	// fetch most recent object
 	winner := votes[winnerIdx]
-	resp, err := cl.FullRead(ctx, winner.sender, r.class, shard, id, search.SelectProperties{}, additional.Properties{}, 9)
-	if err != nil {
-		return false, fmt.Errorf("get most recent object from %s: %w", winner.sender, err)
-	}
-	if resp.UpdateTime() != lastUTime {
-		return false, fmt.Errorf("fetch new state from %s: %w, %v", winner.sender, errConflictObjectChanged, err)
+	resp := objects.Replica{ID: id, Deleted: true, LastUpdateTimeUnixMilli: lastUTime}
+	if !winner.o.Deleted { // a tombstone's digest is all there is to propagate
+		resp, err = cl.FullRead(ctx, winner.sender, r.class, shard, id, search.SelectProperties{}, additional.Properties{}, 9)
+		if err != nil {
+			return false, nil, fmt.Errorf("get most recent object from %s: %w", winner.sender, err)
+		}
+		if resp.UpdateTime() != lastUTime {
+			return false, nil, fmt.Errorf("fetch new state from %s: %w, %v", winner.sender, errConflictObjectChanged, err)
+		}
 	}
 
+	var (
+		mu        sync.Mutex
+		holders   []string // replicas holding the most recent version once repaired
+		deletedAt int64    // a replica deleted the object after the most recent version at this time
+	)
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
 
 	for _, vote := range votes { // repair
 		if vote.UTime == lastUTime {
+			mu.Lock()
+			holders = append(holders, vote.sender)
+			mu.Unlock()
+			continue
+		}
+		if r.keepsExisting(ctx, st, shard, id, vote.sender, vote.missing()) {
 			continue
 		}
 
@@ -289,19 +633,38 @@ func (r *repairer) repairExist(ctx context.Context,
 				StaleUpdateTime:         vote.UTime,
 			}}
 
-			resp, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
+			rs, err := cl.Overwrite(ctx, vote.sender, r.class, shard, ups)
+			r.auditRepairs(ctx, st, shard, vote.sender, ups, rs, err)
+			if errors.Is(err, errRepairDeferred) {
+				return nil // left to a later repair
+			}
 			if err != nil {
 				return fmt.Errorf("node %q could not repair object: %w", vote.sender, err)
 			}
-			if len(resp) > 0 && resp[0].Err != "" {
-				return fmt.Errorf("overwrite %w %s: %s", errConflictObjectChanged, vote.sender, resp[0].Err)
+			if len(rs) > 0 && r.deletedSince(ctx, vote.sender, shard, resp, rs[0]) {
+				mu.Lock()
+				deletedAt = max(deletedAt, rs[0].UpdateTime)
+				mu.Unlock()
+				return nil
 			}
-
+			if len(rs) > 0 && rs[0].Err != "" && !r.converged(ctx, vote.sender, shard, resp, rs[0]) {
+				return fmt.Errorf("overwrite %w %s: %s", errConflictObjectChanged, vote.sender, rs[0].Err)
+			}
+			mu.Lock()
+			holders = append(holders, vote.sender)
+			mu.Unlock()
 			return nil
 		})
 	}
 
-	return !resp.Deleted, gr.Wait()
+	if err := gr.Wait(); err != nil {
+		return !resp.Deleted, resp.Object, err
+	}
+	if deletedAt != 0 {
+		r.propagateTombstone(ctx, st, shard, id, holders, lastUTime, deletedAt)
+		return false, nil, nil
+	}
+	return !resp.Deleted, resp.Object, nil
 }
 
 // repairAll repairs objects when reading them ((use in combination with Finder::GetAll)
@@ -311,6 +674,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 	votes []vote,
 	st rState,
 	contentIdx int,
+	o readOptions,
 ) ([]*storobj.Object, error) {
 	var (
 		result            = make([]*storobj.Object, len(ids)) // final result
@@ -336,7 +700,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 	for i, vote := range votes {
 		if i != contentIdx {
 			for j, x := range vote.DigestData {
-				if curTime := lastTimes[j].T; x.UpdateTime > curTime {
+				if r.compare(x, RepairResponse{ID: x.ID, UpdateTime: lastTimes[j].T}) > 0 {
 					// input object is not up to date
 					lastTimes[j] = iTuple{S: i, O: j, T: x.UpdateTime}
 					reFetchSet[j] = struct{}{} // we need to fetch this object again
@@ -353,14 +717,33 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 		}
 	}
 
+	for i, x := range lastTimes {
+		o.winnerMap.set(ids[i], st.nodeName(votes[x.S].Sender))
+		o.freshest.set(ids[i], x.T)
+	}
+
+	// objects re-fetched for their vectors are read from the designated node if up to date
+	pv := -1
+	if node := r.vectorNodes[shard]; o.vectors && node != "" && !r.breaker.isDegraded(st.NodeMap[node]) {
+		for i, vote := range votes {
+			if vote.Sender == st.NodeMap[node] {
+				pv = i
+			}
+		}
+	}
+
 	// find missing content (diff)
 	for i, p := range votes[contentIdx].FullData {
 		if lastTimes[i].Deleted && lastDeletionTimes[i] == lastTimes[i].T {
 			continue
 		}
 
-		if _, ok := reFetchSet[i]; ok {
-			ms = append(ms, lastTimes[i])
+		if _, ok := reFetchSet[i]; ok || (o.vectors && !hasVectors(p.Object)) {
+			x := lastTimes[i]
+			if pv >= 0 && votes[pv].UpdateTimeAt(i) == x.T {
+				x.S = pv
+			}
+			ms = append(ms, x)
 		} else {
 			result[i] = p.Object
 		}
@@ -369,49 +752,147 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 	if len(ms) > 0 { // fetch most recent objects
 		// partition by hostname
 		sort.SliceStable(ms, func(i, j int) bool { return ms[i].S < ms[j].S })
-		partitions := make([]int, 0, len(votes))
-		pre := ms[0].S
-		for i, y := range ms {
-			if y.S != pre {
-				partitions = append(partitions, i)
-				pre = y.S
-			}
-		}
-		partitions = append(partitions, len(ms))
-
-		// concurrent fetches
-		gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
-		start := 0
-		for _, end := range partitions { // fetch diffs
-			rid := ms[start].S
-			receiver := votes[rid].Sender
-			query := make([]strfmt.UUID, end-start)
-			for j := 0; start < end; start++ {
-				query[j] = ids[ms[start].O]
-				j++
-			}
-			start := start
-			gr.Go(func() error {
-				resp, err := cl.FullReads(ctx, receiver, r.class, shard, query)
-				for i, n := 0, len(query); i < n; i++ {
-					idx := ms[start-n+i].O
-					if err != nil || lastTimes[idx].T != resp[i].UpdateTime() {
-						votes[rid].Count[idx]--
-					} else {
-						result[idx] = resp[i].Object
+		// at most r.maxRefetch objects are fetched per round, the others in later rounds
+		size := len(ms)
+		if r.maxRefetch > 0 {
+			size = r.maxRefetch
+		}
+		for lo := 0; lo < len(ms); lo += size {
+			round := ms[lo:min(lo+size, len(ms))]
+			partitions := make([]int, 0, len(votes))
+			pre := round[0].S
+			for i, y := range round {
+				if y.S != pre {
+					partitions = append(partitions, i)
+					pre = y.S
+				}
+			}
+			partitions = append(partitions, len(round))
+
+			// concurrent fetches
+			gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
+			start := 0
+			for _, end := range partitions { // fetch diffs
+				rid := round[start].S
+				receiver := votes[rid].Sender
+				query := make([]strfmt.UUID, end-start)
+				for j := 0; start < end; start++ {
+					query[j] = ids[round[start].O]
+					j++
+				}
+				start := start
+				gr.Go(func() error {
+					resp, err := cl.FullReads(ctx, receiver, r.class, shard, query)
+					for i, n := 0, len(query); i < n; i++ {
+						idx := round[start-n+i].O
+						if err != nil || lastTimes[idx].T != resp[i].UpdateTime() {
+							votes[rid].Count[idx]--
+							cause := fmt.Errorf("fetch new state from %s: %w", receiver, errConflictObjectChanged)
+							if err != nil {
+								cause = fmt.Errorf("get most recent object from %s: %w", receiver, err)
+							}
+							o.failures.add(ids[idx], cause)
+						} else if cerr := r.dimensionsAgree(ctx, shard, ids[idx],
+							votes[contentIdx].FullData[idx].Object, resp[i].Object); cerr != nil {
+							votes[rid].Count[idx]--
+							o.failures.add(ids[idx], cerr)
+						} else {
+							result[idx] = resp[i].Object
+						}
 					}
+					return nil
+				})
+
+			}
+			if err := gr.Wait(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if o.confirmSource && len(ms) > 0 {
+		r.confirmSources(ctx, shard, ids, votes, ms, result, o)
+	}
+
+	if r.stalest != nil {
+		hosts, lags := make([]string, len(votes)), make([]int64, len(votes))
+		for i, vote := range votes {
+			hosts[i] = vote.Sender
+			for j, x := range lastTimes {
+				if vote.UpdateTimeAt(j) < x.T {
+					lags[i]++ // number of objects behind
 				}
-				return nil
-			})
+			}
+		}
+		r.stalest.observe(st, stalest(hosts, lags))
+	}
+	if r.breaker != nil {
+		for _, vote := range votes {
+			n := 0
+			for j, x := range lastTimes {
+				if x.T-vote.UpdateTimeAt(j) > r.breaker.maxLag {
+					n++
+				}
+			}
+			r.breaker.observe(st, vote.Sender, n)
+		}
+	}
 
+	// objects whose direct read is not the most recent version
+	var changed []strfmt.UUID
+	for i, x := range lastTimes {
+		direct := votes[contentIdx].FullData[i]
+		if x.T == direct.UpdateTime() && x.Deleted == direct.Deleted {
+			continue
 		}
-		if err := gr.Wait(); err != nil {
-			return nil, err
+		deleted := x.Deleted && (r.deletionStrategy == models.ReplicationConfigDeletionStrategyDeleteOnConflict ||
+			r.deletionStrategy == models.ReplicationConfigDeletionStrategyTimeBasedResolution && lastDeletionTimes[i] == x.T)
+		if deleted || !x.Deleted && result[i] != nil {
+			changed = append(changed, ids[i])
 		}
 	}
+	defer r.notifyChanged(shard, changed...)
 
 	// concurrent repairs
+	rctx := ctx
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
+	var cold []batchRepair // sent once the overwrites of recently read objects are done
+
+	send := func(ctx context.Context, x batchRepair) {
+		rs, err := cl.Overwrite(ctx, x.receiver, r.class, shard, x.query)
+		r.auditRepairs(ctx, st, shard, x.receiver, x.query, rs, err)
+		node := st.nodeName(x.receiver)
+		if err != nil {
+			for _, idx := range x.m {
+				votes[x.rid].Count[idx]--
+				cause := fmt.Errorf("node %q could not repair object: %w", x.receiver, err)
+				o.failures.add(ids[idx], cause)
+				o.failures.addOverwrite(node, ids[idx], cause)
+			}
+			return
+		}
+		failed := make(map[int]error)
+		for _, y := range rs {
+			if y.Err != "" {
+				if idx, ok := x.m[y.ID]; ok && !(r.acceptNewerTarget && r.newerTarget(y, lastTimes[idx])) {
+					votes[x.rid].Count[idx]--
+					failed[idx] = fmt.Errorf("overwrite %w %s: %s", errConflictObjectChanged, x.receiver, y.Err)
+					o.failures.add(ids[idx], failed[idx])
+				}
+			}
+		}
+		for _, idx := range x.m {
+			o.failures.addOverwrite(node, ids[idx], failed[idx])
+		}
+	}
+	overwrite := func(ctx context.Context, x batchRepair) {
+		if r.maxRequestBytes <= 0 {
+			send(ctx, x)
+			return
+		}
+		for _, part := range splitBySize(x, r.maxRequestBytes) {
+			send(ctx, part)
+		}
+	}
 
 	for rid, vote := range votes {
 		query := make([]*objects.VObject, 0, len(ids)/2)
@@ -432,7 +913,8 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 					alreadyDeleted = vote.batchReply.DigestData[j].Deleted
 				}
 
-				if alreadyDeleted && lastDeletionTimes[j] == vote.UpdateTimeAt(j) {
+				if alreadyDeleted && lastDeletionTimes[j] == vote.UpdateTimeAt(j) ||
+					r.keepsExisting(ctx, st, shard, ids[j], vote.Sender, vote.missingAt(j)) {
 					continue
 				}
 
@@ -455,7 +937,7 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 
 			cTime := vote.UpdateTimeAt(j)
 
-			if x.T != cTime && vote.Count[j] == nVotes {
+			if x.T != cTime && vote.Count[j] == nVotes && !r.keepsExisting(ctx, st, shard, ids[j], vote.Sender, vote.missingAt(j)) {
 				var latestObject *models.Object
 				var vector []float32
 				var vectors models.Vectors
@@ -490,28 +972,302 @@ func (r *repairer) repairBatchPart(ctx context.Context,
 		if len(query) == 0 {
 			continue
 		}
+		if o.skipRepair(st, vote.Sender) {
+			// not a repair target, its objects are reported as inconsistent
+			for _, idx := range m {
+				votes[rid].Count[idx]--
+			}
+			continue
+		}
+		if o.noRepair {
+			// leave replica stale, its objects are reported as inconsistent
+			for _, idx := range m {
+				votes[rid].Count[idx]--
+			}
+			o.skippedRepair()
+			continue
+		}
+		if o.repairRound != nil {
+			o.repairRound.Store(true)
+		}
 
-		receiver := vote.Sender
-		rid := rid
+		hot := batchRepair{receiver: vote.Sender, rid: rid, query: query, m: m}
+		if r.recent != nil {
+			var c batchRepair
+			hot, c = r.splitRecent(hot)
+			if len(c.query) > 0 {
+				cold = append(cold, c)
+			}
+		}
+		if len(hot.query) > 0 {
+			gr.Go(func() error {
+				overwrite(ctx, hot)
+				return nil
+			})
+		}
+	}
 
+	if err := gr.Wait(); err != nil || len(cold) == 0 {
+		return result, err
+	}
+	gr, ctx = enterrors.NewErrorGroupWithContextWrapper(r.logger, rctx)
+	for _, x := range cold {
+		x := x
 		gr.Go(func() error {
-			rs, err := cl.Overwrite(ctx, receiver, r.class, shard, query)
-			if err != nil {
-				for _, idx := range m {
-					votes[rid].Count[idx]--
-				}
-				return nil
+			overwrite(ctx, x)
+			return nil
+		})
+	}
+	return result, gr.Wait()
+}
+
+// batchRepair is an overwrite sent to a replica by repairBatchPart
+type batchRepair struct {
+	receiver string
+	rid      int // index of the receiver's vote
+	query    []*objects.VObject
+	m        map[string]int // object indexes by id
+}
+
+// splitRecent splits x into the overwrites of recently read objects and the others
+func (r *repairer) splitRecent(x batchRepair) (hot, cold batchRepair) {
+	hot = batchRepair{receiver: x.receiver, rid: x.rid, m: make(map[string]int, len(x.m))}
+	cold = batchRepair{receiver: x.receiver, rid: x.rid, m: make(map[string]int, len(x.m))}
+	for _, obj := range x.query {
+		if r.recent.contains(obj.ID) {
+			hot.query = append(hot.query, obj)
+			hot.m[string(obj.ID)] = x.m[string(obj.ID)]
+		} else {
+			cold.query = append(cold.query, obj)
+			cold.m[string(obj.ID)] = x.m[string(obj.ID)]
+		}
+	}
+	return hot, cold
+}
+
+// confirmSources checks each object in result fetched from votes[x.S] for x in ms
+// against a second replica holding the same version. Objects which cannot be
+// confirmed are removed from result and counted as inconsistent.
+func (r *repairer) confirmSources(ctx context.Context,
+	shard string,
+	ids []strfmt.UUID,
+	votes []vote,
+	ms []iTuple,
+	result []*storobj.Object,
+	o readOptions,
+) {
+	reject := func(x iTuple, cause error) {
+		result[x.O] = nil
+		votes[x.S].Count[x.O]--
+		o.failures.add(ids[x.O], cause)
+	}
+	// group objects by confirming replica
+	byConfirmer := make(map[int][]iTuple, len(votes))
+	for _, x := range ms {
+		if result[x.O] == nil {
+			continue // not fetched
+		}
+		confirmer := -1
+		for i, vote := range votes {
+			if i != x.S && vote.UpdateTimeAt(x.O) == x.T {
+				confirmer = i
+				break
 			}
-			for _, r := range rs {
-				if r.Err != "" {
-					if idx, ok := m[r.ID]; ok {
-						votes[rid].Count[idx]--
-					}
+		}
+		if confirmer < 0 {
+			reject(x, fmt.Errorf("%w: no other replica holds the version of %s", errUnconfirmed, votes[x.S].Sender))
+			continue
+		}
+		byConfirmer[confirmer] = append(byConfirmer[confirmer], x)
+	}
+
+	var mu sync.Mutex // guards votes and result
+	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(r.logger, ctx)
+	for i, xs := range byConfirmer {
+		confirmer, xs := votes[i], xs
+		gr.Go(func() error {
+			query := make([]strfmt.UUID, len(xs))
+			for j, x := range xs {
+				query[j] = ids[x.O]
+			}
+			resp, err := r.client.FullReads(ctx, confirmer.Sender, r.class, shard, query)
+			mu.Lock()
+			defer mu.Unlock()
+			for j, x := range xs {
+				switch {
+				case err != nil:
+					reject(x, fmt.Errorf("%w: read from %s: %w", errUnconfirmed, confirmer.Sender, err))
+				case j >= len(resp) || !sameContent(result[x.O], resp[j].Object):
+					reject(x, fmt.Errorf("%w: %s and %s hold different content", errUnconfirmed,
+						votes[x.S].Sender, confirmer.Sender))
 				}
 			}
 			return nil
 		})
 	}
+	gr.Wait()
+}
 
-	return result, gr.Wait()
+// fetchLatest reads the winning version of object id from votes[winnerIdx].
+// If that fails, up to r.fetchRetries other replicas holding the same version
+// are tried in turn. The error of the winner is returned if none succeeds.
+func (r *repairer) fetchLatest(ctx context.Context,
+	shard string,
+	id strfmt.UUID,
+	votes []objTuple,
+	winnerIdx int,
+) (objects.Replica, error) {
+	winner := votes[winnerIdx]
+	x, err := r.client.FullRead(ctx, winner.sender, r.class, shard, id,
+		search.SelectProperties{}, additional.Properties{}, 9)
+	if err == nil {
+		return x, nil
+	}
+	retries := r.fetchRetries
+	for i, vote := range votes {
+		if retries <= 0 || ctx.Err() != nil {
+			break
+		}
+		if i == winnerIdx || vote.o.Deleted || r.compare(vote.digest(id), winner.digest(id)) != 0 {
+			continue
+		}
+		retries--
+		y, yErr := r.client.FullRead(ctx, vote.sender, r.class, shard, id,
+			search.SelectProperties{}, additional.Properties{}, 9)
+		if yErr == nil {
+			labeled(ctx, r.logger).WithField("op", "repair_one").WithField("class", r.class).
+				WithField("shard", shard).WithField("uuid", id).
+				Debugf("fetched most recent object from %s after %s failed: %v", vote.sender, winner.sender, err)
+			return y, nil
+		}
+	}
+	return x, err
+}
+
+// compare returns a positive number if a is fresher than b, a negative one
+// if b is fresher than a and 0 if they are equally fresh
+func (r *repairer) compare(a, b RepairResponse) int {
+	if r.comparator != nil {
+		return r.comparator(a, b)
+	}
+	return cmp.Compare(a.UpdateTime, b.UpdateTime)
+}
+
+// converged checks whether a conflict reported by host while overwriting it
+// with x can be ignored because host does not hold a stale version anymore
+func (r *repairer) converged(ctx context.Context,
+	host, shard string,
+	x objects.Replica,
+	resp RepairResponse,
+) bool {
+	pushed := iTuple{T: x.UpdateTime(), Deleted: x.Deleted}
+	if r.acceptNewerTarget && r.newerTarget(resp, pushed) {
+		labeled(ctx, r.logger).WithField("op", "repair").WithField("class", r.class).
+			WithField("shard", shard).WithField("uuid", x.ID).WithField("replica", host).
+			Debugf("replica is already newer than pushed version: %d > %d", resp.UpdateTime, x.UpdateTime())
+		return true
+	}
+	return r.hasSameVersion(ctx, host, shard, x, resp)
+}
+
+// newerTarget returns true if resp, returned by a repaired replica, reports a
+// version fresher than the pushed one
+func (r *repairer) newerTarget(resp RepairResponse, pushed iTuple) bool {
+	return r.compare(resp, RepairResponse{ID: resp.ID, UpdateTime: pushed.T, Deleted: pushed.Deleted}) > 0
+}
+
+// deletedSince returns true if tombstones are respected and resp, returned by host when
+// overwritten with x, reports that host deleted the object after x had been written.
+// Such an object is considered deleted rather than being resurrected.
+func (r *repairer) deletedSince(ctx context.Context,
+	host, shard string,
+	x objects.Replica,
+	resp RepairResponse,
+) bool {
+	if !r.respectTombstones || resp.Err == "" || !resp.Deleted || resp.UpdateTime <= x.UpdateTime() {
+		return false
+	}
+	labeled(ctx, r.logger).WithField("op", "repair").WithField("class", r.class).
+		WithField("shard", shard).WithField("uuid", x.ID).WithField("replica", host).
+		Infof("replica deleted the object after the pushed version: %d > %d", resp.UpdateTime, x.UpdateTime())
+	return true
+}
+
+// propagateTombstone deletes object id, as of deletedAt, on hosts which hold its
+// version of update time stale. It is used once a replica reported having deleted
+// the object after that version. Failures are only logged, as they are repaired
+// by later reads.
+func (r *repairer) propagateTombstone(ctx context.Context,
+	st rState, shard string, id strfmt.UUID,
+	hosts []string, stale, deletedAt int64,
+) {
+	gr := enterrors.NewErrorGroupWrapper(r.logger)
+	for _, host := range hosts {
+		host := host
+		gr.Go(func() error {
+			ups := []*objects.VObject{{
+				ID:                      id,
+				Deleted:                 true,
+				LastUpdateTimeUnixMilli: deletedAt,
+				StaleUpdateTime:         stale,
+			}}
+			rs, err := r.client.Overwrite(ctx, host, r.class, shard, ups)
+			r.auditRepairs(ctx, st, shard, host, ups, rs, err)
+			if err == nil && len(rs) > 0 && rs[0].Err != "" {
+				err = errors.New(rs[0].Err)
+			}
+			if err != nil {
+				labeled(ctx, r.logger).WithField("op", "propagate_tombstone").WithField("class", r.class).
+					WithField("shard", shard).WithField("uuid", id).WithField("replica", host).
+					Warnf("cannot propagate deletion: %v", err)
+			}
+			return nil
+		})
+	}
+	gr.Wait()
+}
+
+// hasSameVersion checks whether a conflict reported by host while overwriting
+// it with x is spurious, i.e. host already holds an object with the same
+// update time and identical content (e.g. a concurrent repair got there first).
+func (r *repairer) hasSameVersion(ctx context.Context,
+	host, shard string,
+	x objects.Replica,
+	resp RepairResponse,
+) bool {
+	if resp.UpdateTime != x.UpdateTime() || resp.Deleted != x.Deleted {
+		return false
+	}
+	if x.Deleted {
+		return true
+	}
+	y, err := r.client.FullRead(ctx, host, r.class, shard, x.ID,
+		search.SelectProperties{}, additional.Properties{}, 9)
+	if err != nil || y.Deleted || y.UpdateTime() != x.UpdateTime() {
+		return false
+	}
+	return sameContent(x.Object, y.Object)
+}
+
+// sameContent reports whether a and b have byte-identical payloads.
+// Node local attributes like the doc id are not taken into account.
+func sameContent(a, b *storobj.Object) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	type payload struct {
+		Object       models.Object
+		Vector       []float32
+		Vectors      map[string][]float32
+		MultiVectors map[string][][]float32
+	}
+	x, err := json.Marshal(payload{a.Object, a.Vector, a.Vectors, a.MultiVectors})
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(payload{b.Object, b.Vector, b.Vectors, b.MultiVectors})
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
 }
@@ -13,7 +13,10 @@ package replica
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
 
@@ -67,6 +70,297 @@ func TestRepairerOneWithALL(t *testing.T) {
 		require.Equal(t, item.Object, got)
 	})
 
+	t.Run("DirectReadIsFreshest", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		// C holds the same version, the object is not fetched again
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 1)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+	})
+
+	t.Run("VectorDimensionConflict", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			conflicts []string
+			finder    = f.newFinder("A", WithVectorDimensionCheck(func(_ string, _ strfmt.UUID, vector string) {
+				conflicts = append(conflicts, vector)
+			}))
+			digestIDs = []strfmt.UUID{id}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		item2.Object.Vector = []float32{1, 2, 3}
+		item3.Object.Vector = []float32{1, 2, 3, 4}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorIs(t, err, errVectorDimension)
+		require.Nil(t, got)
+		require.Equal(t, []string{""}, conflicts)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("RepairMissingOnly", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithRepairMissingOnly())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR0  = []RepairResponse{{ID: id.String()}}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR0, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR0, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		// C is missing the object and gets it, B holds a stale version and is left alone
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+		f.assertLogContains(t, "node", "B")
+	})
+
+	t.Run("AuthoritativeTombstone", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAuthoritativeTombstones())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			// B deleted the object after the version pushed to it
+			deletedR4 = []RepairResponse{{ID: id.String(), UpdateTime: 4, Deleted: true, Err: "conflict"}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(deletedR4, nil)
+
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Nil(t, got)
+		// the deletion is propagated to the replicas holding the pushed version
+		tombstone := []*objects.VObject{{ID: id, Deleted: true, LastUpdateTimeUnixMilli: 4, StaleUpdateTime: 3}}
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, tombstone)
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[2], cls, shard, tombstone)
+
+		// by default the rejected overwrite fails the read
+		f = newFakeFactory("C1", shard, nodes)
+		finder = f.newFinder("A")
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(deletedR4, nil)
+
+		_, err = finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorContains(t, err, errRepair.Error())
+	})
+
+	t.Run("AuthoritativeTombstoneRepairedReplica", func(t *testing.T) {
+		var (
+			nodes     = []string{"A", "B", "C", "D"}
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAuthoritativeTombstones())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			deletedR4 = []RepairResponse{{ID: id.String(), UpdateTime: 4, Deleted: true, Err: "conflict"}}
+			tombstone = []*objects.VObject{{ID: id, Deleted: true, LastUpdateTimeUnixMilli: 4, StaleUpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[3], cls, shard, digestIDs).Return(digestR2, nil)
+		// B deleted the object after the version pushed to it, while D accepts it
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(deletedR4, nil)
+		for _, n := range []string{nodes[0], nodes[2], nodes[3]} {
+			f.RClient.On("OverwriteObjects", anyVal, n, cls, shard, anyVal).Return([]RepairResponse{}, nil)
+		}
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Nil(t, got)
+		// D, repaired concurrently to the rejected overwrite, does not keep the pushed version
+		for _, n := range []string{nodes[0], nodes[2], nodes[3]} {
+			f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, n, cls, shard, tombstone)
+		}
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, tombstone)
+	})
+
+	t.Run("GetContentFromDirectReadWithDigests", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2, Version: 1}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Version: 2}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, digests, err := finder.GetOneWithDigests(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		want := map[string]RepairResponse{
+			"A": {ID: id.String(), UpdateTime: 3},
+			"B": digestR2[0],
+			"C": digestR3[0],
+		}
+		require.Equal(t, want, digests)
+	})
+
+	t.Run("MetadataOnly", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			meta      = objects.Replica{ID: id, Object: object(id, 3)}
+			full      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			metaAdds  = additional.Properties{NoProps: true, CreationTimeUnix: true, LastUpdateTimeUnix: true}
+		)
+		full.Object.Object.Properties = map[string]interface{}{"name": "full"}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, search.SelectProperties(nil), metaAdds).Return(meta, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// stale replicas are repaired with the full object, not with the metadata
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(full, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(full, nil)
+		updates := []*objects.VObject{{
+			ID:                      id,
+			LastUpdateTimeUnixMilli: 3,
+			LatestObject:            &full.Object.Object,
+			StaleUpdateTime:         2,
+		}}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, updates).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithMetadataOnly())
+		require.NoError(t, err)
+		require.Equal(t, full.Object, got)
+	})
+
+	t.Run("ClockSkew", func(t *testing.T) {
+		var (
+			f      = newFakeFactory("C1", shard, nodes)
+			skewed []string
+			finder = f.newFinder("A", WithClockSkewTolerance(time.Hour, func(node string, ahead time.Duration) {
+				skewed = append(skewed, node)
+			}))
+			digestIDs = []strfmt.UUID{id}
+			future    = int64(3 + 24*time.Hour/time.Millisecond)
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestRF  = []RepairResponse{{ID: id.String(), UpdateTime: future}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestRF, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorIs(t, err, errClockSkew)
+		require.ErrorContains(t, err, "C is 24h0m0s ahead")
+		require.Equal(t, nilObject, got)
+		require.Equal(t, []string{"C"}, skewed)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("RepairByteLimit", func(t *testing.T) {
+		var (
+			f        = newFakeFactory("C1", shard, nodes)
+			deferred []string
+			finder   = f.newFinder("A", WithRepairByteLimit(1, time.Hour, func(shard string, bytes int64) {
+				deferred = append(deferred, shard)
+				require.Positive(t, bytes)
+			}))
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+
+		// the first repair reaches the limit, the next ones are deferred
+		for i := 0; i < 3; i++ {
+			got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+			require.NoError(t, err)
+			require.Equal(t, item.Object, got)
+		}
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		require.Equal(t, []string{shard, shard}, deferred)
+	})
+
+	t.Run("AuditEvents", func(t *testing.T) {
+		var (
+			events    = make(chan AuditEvent, 8)
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAuditEvents(events))
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			ctx       = context.WithValue(ctx, "principal", &models.Principal{Username: "alice"})
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+
+		_, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		close(events)
+
+		var got []AuditEvent
+		for e := range events {
+			require.False(t, e.Time.IsZero())
+			e.Time = time.Time{}
+			got = append(got, e)
+		}
+		want := []AuditEvent{
+			{
+				Kind: AuditRepair, User: "alice", Class: cls, Shard: shard, ID: id, Level: All,
+				Node: nodes[1], UpdateTime: 3, StaleUpdateTime: 2,
+			},
+			{
+				Kind: AuditRead, User: "alice", Class: cls, Shard: shard, ID: id, Level: All,
+				UpdateTime: 3,
+			},
+		}
+		require.Equal(t, want, got)
+	})
+
 	t.Run("ChangedObject", func(t *testing.T) {
 		vectors := map[string]models.Vector{"test": []float32{1, 2, 3}}
 		var (
@@ -102,6 +396,122 @@ func TestRepairerOneWithALL(t *testing.T) {
 		f.assertLogErrorContains(t, "conflict")
 	})
 
+	t.Run("ChangedObjectNewerThanPushed", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAcceptNewerReplicas())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			digestR4  = []RepairResponse{{ID: id.String(), UpdateTime: 4, Err: "conflict"}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR4, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+	})
+
+	t.Run("ChangedObjectGenuineConflict", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAcceptNewerReplicas())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			// replica changed, but is still older than the pushed version
+			digestR1 = []RepairResponse{{ID: id.String(), UpdateTime: 1, Err: "conflict"}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR1, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorContains(t, err, errRepair.Error())
+		require.Nil(t, got)
+		f.assertLogErrorContains(t, "conflict")
+	})
+
+	t.Run("ChangedObjectNewerByComparator", func(t *testing.T) {
+		var (
+			f = newFakeFactory("C1", shard, nodes)
+			// older versions win
+			inverted  = func(a, b RepairResponse) int { return int(b.UpdateTime - a.UpdateTime) }
+			finder    = f.newFinder("A", WithAcceptNewerReplicas(), WithComparator(inverted))
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 2)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			// replica changed to a version the comparator considers newer
+			digestR1 = []RepairResponse{{ID: id.String(), UpdateTime: 1, Err: "conflict"}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR1, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+	})
+
+	t.Run("ChangedObjectWithSameContent", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			item2     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			// node B has been repaired concurrently to the same version
+			digestR4 = []RepairResponse{{ID: id.String(), UpdateTime: 3, Err: "conflict"}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR4, nil).Once()
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item2, nil).Once()
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.NoError(t, err)
+		require.Equal(t, item.Object, got)
+		// B was stale when read, so it is sent the object once. The conflict it
+		// reports is only known after that overwrite: with equal update times and
+		// identical content it is not a conflict and B is not repaired again.
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 2)
+	})
+
+	t.Run("ChangedObjectWithDifferentContent", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			item2     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			digestR4  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Err: "conflict"}}
+		)
+		item2.Object.Object.Properties = map[string]interface{}{"name": "other"}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR4, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorContains(t, err, errRepair.Error())
+		require.Nil(t, got)
+	})
+
 	t.Run("GetContentFromIndirectRead", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
@@ -131,12 +541,429 @@ func TestRepairerOneWithALL(t *testing.T) {
 		require.Equal(t, item3.Object, got)
 	})
 
-	t.Run("OverwriteError", func(t *testing.T) {
+	t.Run("GetContentFromIndirectReadRPCStats", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
 			finder    = f.newFinder("A")
 			digestIDs = []strfmt.UUID{id}
-			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			stats     RPCStats
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithRPCStats(&stats))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		// a direct read and a digest read per other replica, then the most
+		// recent object is fetched from one of them to repair the direct replica
+		want := RPCStats{FetchObject: 2, DigestObjects: 2, OverwriteObjects: 1}
+		require.Equal(t, want, stats)
+		require.Equal(t, 5, stats.Total())
+	})
+
+	t.Run("StalestNodeHook", func(t *testing.T) {
+		var (
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			f         = newFakeFactory("C1", shard, nodes)
+			stalest   []string
+			finder    = f.newFinder("A", WithStalestNodeHook(4, 0.75, func(node string, frequency float64) {
+				require.Equal(t, 1.0, frequency)
+				stalest = append(stalest, node)
+			}))
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR2, nil)
+
+		// C is always behind, it is reported once the window is full
+		for i := 0; i < 6; i++ {
+			got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+			require.Nil(t, err)
+			require.Equal(t, item.Object, got)
+			if i < 3 {
+				require.Empty(t, stalest)
+			}
+		}
+		require.Equal(t, []string{"C"}, stalest)
+	})
+
+	t.Run("ChangeHook", func(t *testing.T) {
+		var (
+			digestIDs = []strfmt.UUID{id}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		newFinder := func(changed *[]strfmt.UUID) (*fakeFactory, *Finder) {
+			f := newFakeFactory("C1", shard, nodes)
+			return f, f.newFinder("A", WithChangeHook(func(sh string, ids []strfmt.UUID) {
+				require.Equal(t, shard, sh)
+				*changed = append(*changed, ids...)
+			}))
+		}
+
+		// the direct read is stale, the returned object differs from it
+		var changed []strfmt.UUID
+		f, finder := newFinder(&changed)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		require.Equal(t, []strfmt.UUID{id}, changed)
+
+		// only a lagging peer is repaired, the returned object is the direct read
+		changed = nil
+		f, finder = newFinder(&changed)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, err = finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		require.Empty(t, changed)
+	})
+
+	t.Run("PropertyScopedRepair", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			props     = search.SelectProperties{{Name: "name", IsPrimitive: true}}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		item2.Object.Object.Properties = map[string]interface{}{"name": "A"}
+		item3.Object.Object.Properties = map[string]interface{}{"name": "A", "description": "changed"}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, props, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, props, adds, WithPropertyScopedRepair())
+		require.Nil(t, err)
+		require.Equal(t, item2.Object, got)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, cls, shard, anyVal)
+
+		// a difference in a requested property is repaired
+		item3.Object.Object.Properties = map[string]interface{}{"name": "B"}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR3, nil)
+
+		got, err = finder.GetOne(ctx, All, shard, id, props, adds, WithPropertyScopedRepair())
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+	})
+
+	t.Run("Staleness", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			props     = search.SelectProperties{{Name: "name", IsPrimitive: true}}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			staleness map[strfmt.UUID]time.Duration
+		)
+		item2.Object.Object.Properties = map[string]interface{}{"name": "A"}
+		item3.Object.Object.Properties = map[string]interface{}{"name": "A", "description": "changed"}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, props, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+
+		// repair is skipped, the stale version is returned
+		got, err := finder.GetOne(ctx, All, shard, id, props, adds,
+			WithPropertyScopedRepair(), WithStaleness(&staleness))
+		require.Nil(t, err)
+		require.Equal(t, item2.Object, got)
+		require.Equal(t, map[strfmt.UUID]time.Duration{id: time.Millisecond}, staleness)
+
+		// the repaired version is up to date
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR3, nil)
+		got, err = finder.GetOne(ctx, All, shard, id, props, adds, WithStaleness(&staleness))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		require.Equal(t, map[strfmt.UUID]time.Duration{id: 0}, staleness)
+	})
+
+	t.Run("RepairTargets", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR2, nil)
+		// B is stale as well but only C is repaired
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithRepairTargets("C"))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+	})
+
+	t.Run("TimestampedTombstone", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			digestR5  = []RepairResponse{{ID: id.String(), UpdateTime: 5, Deleted: true}}
+			want      = &objects.VObject{ID: id, Deleted: true, LastUpdateTimeUnixMilli: 5, StaleUpdateTime: 3}
+		)
+		finder.deletionStrategy = models.ReplicationConfigDeletionStrategyTimeBasedResolution
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR5, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// the deletion at 5 is more recent than the object at 3 and propagates
+		for _, n := range []string{nodes[0], nodes[2]} {
+			f.RClient.On("OverwriteObjects", anyVal, n, cls, shard, []*objects.VObject{want}).Return(digestR5, nil)
+		}
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Nil(t, got)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 2)
+
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR3, nil)
+		exists, err := finder.Exists(ctx, All, shard, id)
+		require.Nil(t, err)
+		require.False(t, exists)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 4)
+	})
+
+	t.Run("TombstoneOlderThanObject", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item5     = objects.Replica{ID: id, Object: object(id, 5)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3, Deleted: true}}
+			digestR5  = []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+		)
+		finder.deletionStrategy = models.ReplicationConfigDeletionStrategyTimeBasedResolution
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item5, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR5, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR5, nil).
+			RunFn = func(a mock.Arguments) {
+			xs := a[4].([]*objects.VObject)
+			require.False(t, xs[0].Deleted)
+			require.Equal(t, int64(5), xs[0].LastUpdateTimeUnixMilli)
+		}
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item5.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+	})
+
+	t.Run("LocalVersion", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		// A does not report yet the version 4 it has just been written with
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithLocalVersion(4))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, anyVal, cls, shard, anyVal)
+
+		// without the hint A is repaired backward
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR3, nil)
+		_, err = finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+	})
+
+	t.Run("PartialRepair", func(t *testing.T) {
+		var (
+			nodes     = []string{"A", "B", "C", "D"}
+			f         = newFakeFactory("C1", shard, nodes)
+			digestIDs = []strfmt.UUID{id}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("DigestObjects", anyVal, n, cls, shard, digestIDs).Return(digestR2, nil)
+		}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[3], cls, shard, anyVal).Return(digestR2, errAny)
+
+		// all four replicas are read but quorum only requires three of them to be consistent
+		_, err := f.newFinder("A").GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(4))
+		require.ErrorIs(t, err, errAny)
+
+		got, err := f.newFinder("A", WithPartialRepair()).GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(4))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+
+		// two failed overwrites leave too few consistent replicas
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Unset()
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR2, errAny)
+		_, err = f.newFinder("A", WithPartialRepair()).GetOne(ctx, Quorum, shard, id, proj, adds, WithMinAcks(4))
+		require.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("RepairDeadlineShare", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			release   = make(chan struct{})
+			repaired  = make(chan struct{})
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// a slow overwrite
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil).
+			Run(func(mock.Arguments) {
+				<-release
+				close(repaired)
+			})
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		start := time.Now()
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithRepairDeadlineShare(0.01))
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		require.Less(t, time.Since(start), time.Second)
+
+		// the repair is deferred, not abandoned: closing the finder waits for it
+		closeCtx, cancelClose := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancelClose()
+		go func() {
+			<-closeCtx.Done()
+			close(release)
+		}()
+		require.ErrorIs(t, finder.Close(closeCtx), context.DeadlineExceeded)
+		select {
+		case <-repaired:
+		default:
+			t.Fatal("finder closed before the deferred repair completed")
+		}
+	})
+
+	t.Run("GetContentFromIndirectReadTraced", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR2, nil)
+
+		got, trace, err := finder.GetOneTraced(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+
+		require.ElementsMatch(t, []ReplicaTrace{
+			{Node: "A", UpdateTime: 2},
+			{Node: "B", UpdateTime: 3, Digest: true},
+			{Node: "C", UpdateTime: 3, Digest: true},
+		}, trace.Replicas)
+		require.Contains(t, []string{"B", "C"}, trace.Winner)
+		require.Equal(t, int64(3), trace.WinnerUpdateTime)
+		require.Equal(t, []RepairTrace{{Node: "A", StaleUpdateTime: 2, UpdateTime: 3}}, trace.Repairs)
+		require.Equal(t, item3.Object, trace.Result)
+		require.Empty(t, trace.Err)
+	})
+
+	t.Run("CustomComparator", func(t *testing.T) {
+		var (
+			f = newFakeFactory("C1", shard, nodes)
+			// older versions win
+			inverted  = func(a, b RepairResponse) int { return int(b.UpdateTime - a.UpdateTime) }
+			finder    = f.newFinder("A", WithComparator(inverted))
+			digestIDs = []strfmt.UUID{id}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// called during reparation to fetch the winning object
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item2, nil)
+		for _, n := range []string{nodes[0], nodes[2]} {
+			f.RClient.On("OverwriteObjects", anyVal, n, cls, shard, anyVal).
+				Return(digestR3, nil).RunFn = func(a mock.Arguments) {
+				updates := a[4].([]*objects.VObject)[0]
+				require.Equal(t, int64(3), updates.StaleUpdateTime)
+				require.Equal(t, int64(2), updates.LastUpdateTimeUnixMilli)
+			}
+		}
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item2.Object, got)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal)
+	})
+
+	t.Run("OverwriteError", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
 			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
 			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
 		)
@@ -159,8 +986,74 @@ func TestRepairerOneWithALL(t *testing.T) {
 		require.Nil(t, got)
 		f.assertLogContains(t, "msg", "A:3", "B:2", "C:3")
 	})
-
-	t.Run("CannotGetMostRecentObject", func(t *testing.T) {
+
+	t.Run("Labels", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, errAny)
+
+		lctx := ContextWithLabels(ctx, map[string]string{"workload": "backfill"})
+		_, err := finder.GetOne(lctx, All, shard, id, proj, adds)
+		require.ErrorContains(t, err, errRepair.Error())
+		f.assertLogContains(t, "workload", "backfill")
+		f.assertLogContains(t, "msg", "A:3", "B:2", "C:3")
+	})
+
+	t.Run("CannotGetMostRecentObject", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item1     = objects.Replica{ID: id, Object: object(id, 1)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item1, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// called during reparation to fetch the most recent object
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(emptyItem, errAny)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.ErrorContains(t, err, errRepair.Error())
+		require.ErrorContains(t, err, msgCLevel)
+		require.Nil(t, got)
+		f.assertLogContains(t, "msg", "A:1", "B:2", "C:3")
+	})
+
+	t.Run("CannotGetMostRecentObjectWithFetchRetries", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithFetchRetries(1))
+			digestIDs = []strfmt.UUID{id}
+			item1     = objects.Replica{ID: id, Object: object(id, 1)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			peer      = mock.MatchedBy(func(node string) bool { return node != nodes[0] })
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item1, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		// B and C hold the same version, whichever wins fails and the other one serves it
+		f.RClient.On("FetchObject", anyVal, peer, cls, shard, id, proj, adds).Return(emptyItem, errAny).Once()
+		f.RClient.On("FetchObject", anyVal, peer, cls, shard, id, proj, adds).Return(item3, nil).Once()
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return(digestR3, nil)
+
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
+		require.Nil(t, err)
+		require.Equal(t, item3.Object, got)
+		f.RClient.AssertNumberOfCalls(t, "FetchObject", 3)
+	})
+	t.Run("MostRecentObjectChanged", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
 			finder    = f.newFinder("A")
@@ -173,36 +1066,76 @@ func TestRepairerOneWithALL(t *testing.T) {
 		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
 		// called during reparation to fetch the most recent object
-		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(emptyItem, errAny)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).
+			Return(item1, nil).Once()
 
 		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
-		require.ErrorContains(t, err, errRepair.Error())
 		require.ErrorContains(t, err, msgCLevel)
+		require.ErrorContains(t, err, errRepair.Error())
 		require.Nil(t, got)
 		f.assertLogContains(t, "msg", "A:1", "B:2", "C:3")
+		f.assertLogErrorContains(t, errConflictObjectChanged.Error())
 	})
-	t.Run("MostRecentObjectChanged", func(t *testing.T) {
+
+	t.Run("MostRecentObjectChangedWithOlderFallback", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
 			finder    = f.newFinder("A")
 			digestIDs = []strfmt.UUID{id}
 			item1     = objects.Replica{ID: id, Object: object(id, 1)}
+			item2     = objects.Replica{ID: id, Object: object(id, 2)}
 			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
 			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			older     bool
+			staleness map[strfmt.UUID]time.Duration
 		)
 		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item1, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
 		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
-		// called during reparation to fetch the most recent object
+		// most recent object changed in the meantime
 		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).
 			Return(item1, nil).Once()
+		// runner-up version
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).
+			Return(item2, nil).Once()
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).
+			Return([]RepairResponse{}, nil).RunFn = func(a mock.Arguments) {
+			updates := a[4].([]*objects.VObject)[0]
+			require.Equal(t, int64(1), updates.StaleUpdateTime)
+			require.Equal(t, int64(2), updates.LastUpdateTimeUnixMilli)
+		}
 
-		got, err := finder.GetOne(ctx, All, shard, id, proj, adds)
-		require.ErrorContains(t, err, msgCLevel)
-		require.ErrorContains(t, err, errRepair.Error())
-		require.Nil(t, got)
-		f.assertLogContains(t, "msg", "A:1", "B:2", "C:3")
-		f.assertLogErrorContains(t, errConflictObjectChanged.Error())
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds,
+			WithOlderFallback(&older), WithStaleness(&staleness))
+		require.NoError(t, err)
+		require.Equal(t, item2.Object, got)
+		require.True(t, older)
+		// the fallback keeps the caller's options
+		require.Equal(t, map[strfmt.UUID]time.Duration{id: time.Millisecond}, staleness)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal)
+	})
+
+	t.Run("MostRecentObjectChangedWithAgreedOlderVersion", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A")
+			digestIDs = []strfmt.UUID{id}
+			item1     = objects.Replica{ID: id, Object: object(id, 1)}
+			item3     = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			digestR4  = []RepairResponse{{ID: id.String(), UpdateTime: 4}}
+			older     bool
+		)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil).Once()
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR4, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).
+			Return(item1, nil).Once()
+		// C:4 changed, A:3 and B:3 agree on the runner-up, nothing to repair
+		got, err := finder.GetOne(ctx, All, shard, id, proj, adds, WithOlderFallback(&older))
+		require.NoError(t, err)
+		require.Equal(t, item3.Object, got)
+		require.True(t, older)
 	})
 
 	t.Run("CreateMissingObject", func(t *testing.T) {
@@ -278,6 +1211,34 @@ func TestRepairerExistsWithALL(t *testing.T) {
 		emptyItem = objects.Replica{}
 	)
 
+	t.Run("AuthoritativeTombstone", func(t *testing.T) {
+		var (
+			f         = newFakeFactory("C1", shard, nodes)
+			finder    = f.newFinder("A", WithAuthoritativeTombstones())
+			digestIDs = []strfmt.UUID{id}
+			item      = objects.Replica{ID: id, Object: object(id, 3)}
+			digestR2  = []RepairResponse{{ID: id.String(), UpdateTime: 2}}
+			digestR3  = []RepairResponse{{ID: id.String(), UpdateTime: 3}}
+			deletedR4 = []RepairResponse{{ID: id.String(), UpdateTime: 4, Deleted: true, Err: "conflict"}}
+			tombstone = []*objects.VObject{{ID: id, Deleted: true, LastUpdateTimeUnixMilli: 4, StaleUpdateTime: 3}}
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[0], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item, nil)
+		// B deleted the object after the version pushed to it
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(deletedR4, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, tombstone).Return([]RepairResponse{}, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, tombstone).Return([]RepairResponse{}, nil)
+
+		got, err := finder.Exists(ctx, All, shard, id)
+		require.NoError(t, err)
+		require.False(t, got)
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, tombstone)
+		f.RClient.AssertCalled(t, "OverwriteObjects", anyVal, nodes[2], cls, shard, tombstone)
+	})
+
 	t.Run("ChangedObject", func(t *testing.T) {
 		var (
 			f         = newFakeFactory("C1", shard, nodes)
@@ -788,6 +1749,415 @@ func TestRepairerCheckConsistencyAll(t *testing.T) {
 		require.Equal(t, want, directR)
 	})
 
+	t.Run("RepairErrors", func(t *testing.T) {
+		var (
+			ids      = []strfmt.UUID{"1", "2", "3", "4", "5"}
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A", WithRepairErrors())
+			directR  = make([]*storobj.Object, len(ids))
+			directRe = make([]objects.Replica, len(ids))
+			digestR2 = make([]RepairResponse, len(ids)) // B is stale
+			digestR3 = make([]RepairResponse, len(ids))
+		)
+		for i, id := range ids {
+			directR[i] = objectEx(id, 5, shard, "A")
+			directRe[i] = replica(id, 5, false)
+			digestR2[i] = RepairResponse{ID: id.String(), UpdateTime: 1}
+			digestR3[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+		}
+		// two of the five overwrites conflict
+		overwriteR := []RepairResponse{
+			{ID: ids[1].String(), Err: "conflict"},
+			{ID: ids[3].String(), Err: "conflict"},
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, anyVal).Return(directRe, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(overwriteR, nil)
+
+		err := finder.CheckConsistency(ctx, All, directR)
+		require.ErrorIs(t, err, errRepair)
+		var repairErr *RepairError
+		require.ErrorAs(t, err, &repairErr)
+		require.Equal(t, []strfmt.UUID{ids[1], ids[3]}, repairErr.IDs())
+		for _, x := range repairErr.Failures {
+			require.ErrorIs(t, x.Err, errConflictObjectChanged)
+		}
+		require.ErrorContains(t, err, "2 objects could not be repaired")
+		for i, x := range directR {
+			require.Equal(t, i != 1 && i != 3, x.IsConsistent)
+		}
+	})
+
+	t.Run("RepairErrorsByNode", func(t *testing.T) {
+		var (
+			ids      = []strfmt.UUID{"1", "2", "3"}
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A", WithRepairErrors())
+			directR  = make([]*storobj.Object, len(ids))
+			directRe = make([]objects.Replica, len(ids))
+			digestR  = make([]RepairResponse, len(ids)) // B and C are stale
+		)
+		for i, id := range ids {
+			directR[i] = objectEx(id, 5, shard, "A")
+			directRe[i] = replica(id, 5, false)
+			digestR[i] = RepairResponse{ID: id.String(), UpdateTime: 1}
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, anyVal).Return(directRe, nil)
+		// B is unreachable and C rejects one overwrite
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return([]RepairResponse{}, errAny)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).
+			Return([]RepairResponse{{ID: ids[1].String(), Err: "conflict"}}, nil)
+
+		err := finder.CheckConsistency(ctx, All, directR)
+		var repairErr *RepairError
+		require.ErrorAs(t, err, &repairErr)
+		require.Equal(t, []string{"B", "C"}, repairErr.FailedNodes())
+		require.Len(t, repairErr.Nodes, 2)
+
+		b, c := repairErr.Nodes[0], repairErr.Nodes[1]
+		require.Equal(t, "B", b.Node)
+		require.Empty(t, b.Succeeded)
+		require.Len(t, b.Failed, 3)
+		for i, x := range b.Failed {
+			require.Equal(t, ids[i], x.ID)
+			require.ErrorIs(t, x.Err, errAny)
+		}
+		require.Equal(t, "C", c.Node)
+		require.Equal(t, []strfmt.UUID{ids[0], ids[2]}, c.Succeeded)
+		require.Len(t, c.Failed, 1)
+		require.Equal(t, ids[1], c.Failed[0].ID)
+		require.ErrorIs(t, c.Failed[0].Err, errConflictObjectChanged)
+	})
+
+	t.Run("SizeOrderedRepairs", func(t *testing.T) {
+		var (
+			ids      = []strfmt.UUID{"1", "2", "3", "4"}
+			dims     = []int{1000, 10, 100, 10} // overwrites of ~2200, 200, 400 and 200 bytes
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A", WithSizeOrderedRepairs(512))
+			directR  = make([]*storobj.Object, len(ids))
+			digestR2 = make([]RepairResponse, len(ids)) // B is stale
+			digestR3 = make([]RepairResponse, len(ids))
+			got      [][]strfmt.UUID
+		)
+		for i, id := range ids {
+			directR[i] = objectEx(id, 5, shard, "A")
+			directR[i].Vector = make([]float32, dims[i])
+			digestR2[i] = RepairResponse{ID: id.String(), UpdateTime: 1}
+			digestR3[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).
+			Return([]RepairResponse{}, nil).
+			RunFn = func(a mock.Arguments) {
+			var request []strfmt.UUID
+			for _, x := range a[4].([]*objects.VObject) {
+				request = append(request, x.ID)
+			}
+			got = append(got, request)
+		}
+
+		err := finder.CheckConsistency(ctx, All, directR)
+		require.Nil(t, err)
+		// smallest first, equal sizes keep their order, larger objects are sent alone
+		want := [][]strfmt.UUID{{ids[1], ids[3]}, {ids[2]}, {ids[0]}}
+		require.Equal(t, want, got)
+		for _, x := range directR {
+			require.True(t, x.IsConsistent)
+		}
+	})
+
+	t.Run("RecentReadPriority", func(t *testing.T) {
+		var (
+			ids      = []strfmt.UUID{"1", "2", "3"}
+			hot      = ids[2]
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A", WithRepairByteLimit(1, time.Hour, nil), WithRecentReadPriority(2))
+			directR  = make([]*storobj.Object, len(ids))
+			digestR2 = make([]RepairResponse, len(ids)) // B is stale
+			digestR3 = make([]RepairResponse, len(ids))
+			got      []strfmt.UUID
+		)
+		for i, id := range ids {
+			directR[i] = objectEx(id, 5, shard, "A")
+			digestR2[i] = RepairResponse{ID: id.String(), UpdateTime: 1}
+			digestR3[i] = RepairResponse{ID: id.String(), UpdateTime: 5}
+		}
+		item := objects.Replica{ID: hot, Object: objectEx(hot, 5, shard, "A")}
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, hot, anyVal, anyVal).Return(item, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).
+			Return([]RepairResponse{}, nil).
+			RunFn = func(a mock.Arguments) {
+			for _, x := range a[4].([]*objects.VObject) {
+				got = append(got, x.ID)
+			}
+		}
+
+		_, err := finder.GetOne(ctx, One, shard, hot, nil, additional.Properties{})
+		require.Nil(t, err)
+
+		// the budget is spent on the recently read object, the cold ones are deferred
+		err = finder.CheckConsistency(ctx, All, directR)
+		require.Nil(t, err)
+		require.Equal(t, []strfmt.UUID{hot}, got)
+		require.True(t, directR[2].IsConsistent)
+		require.False(t, directR[0].IsConsistent)
+		require.False(t, directR[1].IsConsistent)
+	})
+
+	t.Run("MaxRefetch", func(t *testing.T) {
+		var (
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A", WithMaxRefetch(3))
+			n        = 10
+			ids      = make([]strfmt.UUID, n)
+			directR  = make([]*storobj.Object, n) // A and C are stale
+			digestR1 = make([]RepairResponse, n)
+			digestR5 = make([]RepairResponse, n)
+			fetched  = make([]objects.Replica, n)
+		)
+		for i := range ids {
+			ids[i] = strfmt.UUID(strconv.Itoa(10 + i))
+			directR[i] = objectEx(ids[i], 1, shard, "A")
+			digestR1[i] = RepairResponse{ID: ids[i].String(), UpdateTime: 1}
+			digestR5[i] = RepairResponse{ID: ids[i].String(), UpdateTime: 5}
+			fetched[i] = replica(ids[i], 5, false)
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR5, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR1, nil)
+		for lo := 0; lo < n; lo += 3 {
+			hi := min(lo+3, n)
+			f.RClient.On("FetchObjects", anyVal, nodes[1], cls, shard, ids[lo:hi]).Return(fetched[lo:hi], nil)
+		}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return([]RepairResponse{}, nil)
+
+		err := finder.CheckConsistency(ctx, All, directR)
+		require.Nil(t, err)
+		// 10 objects are fetched in rounds of at most 3
+		f.RClient.AssertNumberOfCalls(t, "FetchObjects", 4)
+		for i, x := range directR {
+			require.True(t, x.IsConsistent, i)
+			require.Equal(t, int64(5), x.LastUpdateTimeUnix(), i)
+		}
+	})
+
+	t.Run("ConfirmedRepairSource", func(t *testing.T) {
+		var (
+			id       = ids[0]
+			f        = newFakeFactory("C1", shard, nodes)
+			finder   = f.newFinder("A")
+			directR  = []*storobj.Object{objectEx(id, 1, shard, "A")} // A is stale
+			digestR  = []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+			fetched  = []objects.Replica{replica(id, 5, false)}
+			mu       sync.Mutex
+			readers  []string
+			repaired bool
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR, nil)
+		for _, n := range nodes[1:] {
+			n := n
+			f.RClient.On("FetchObjects", anyVal, n, cls, shard, anyVal).Return(fetched, nil).
+				RunFn = func(a mock.Arguments) {
+				mu.Lock()
+				defer mu.Unlock()
+				readers = append(readers, n)
+			}
+		}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).
+			Return([]RepairResponse{}, nil).
+			Once().
+			RunFn = func(a mock.Arguments) {
+			// the source has been read and confirmed by the other replica
+			require.ElementsMatch(t, nodes[1:], readers)
+			repaired = true
+		}
+
+		err := finder.CheckConsistency(ctx, All, directR, WithConfirmedRepairSource())
+		require.Nil(t, err)
+		require.True(t, repaired)
+		require.True(t, directR[0].IsConsistent)
+	})
+
+	t.Run("UnconfirmedRepairSource", func(t *testing.T) {
+		var (
+			id      = ids[0]
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A", WithRepairErrors())
+			directR = []*storobj.Object{objectEx(id, 1, shard, "A")} // A is stale
+			digestR = []RepairResponse{{ID: id.String(), UpdateTime: 5}}
+			repErr  *RepairError
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR, nil)
+		// B and C claim the same version but hold different content
+		for i, n := range nodes[1:] {
+			x := replica(id, 5, false)
+			x.Object.Object.Properties = map[string]interface{}{"n": float64(i)}
+			f.RClient.On("FetchObjects", anyVal, n, cls, shard, anyVal).Return([]objects.Replica{x}, nil)
+		}
+
+		err := finder.CheckConsistency(ctx, All, directR, WithConfirmedRepairSource())
+		require.ErrorAs(t, err, &repErr)
+		require.Len(t, repErr.Failures, 1)
+		require.ErrorIs(t, repErr.Failures[0].Err, errUnconfirmed)
+		require.False(t, directR[0].IsConsistent)
+		f.RClient.AssertNotCalled(t, "OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal)
+	})
+
+	t.Run("StalenessBreaker", func(t *testing.T) {
+		var (
+			f        = newFakeFactory("C1", shard, nodes)
+			degraded []string
+			finder   = f.newFinder("C", WithStalenessBreaker(100, 2, func(node string) {
+				degraded = append(degraded, node)
+			}))
+			directR = []*storobj.Object{
+				objectEx(ids[0], 1004, shard, "A"),
+				objectEx(ids[1], 1005, shard, "A"),
+				objectEx(ids[2], 1006, shard, "A"),
+			}
+			directRe = []objects.Replica{
+				replica(ids[0], 1004, false),
+				replica(ids[1], 1005, false),
+				replica(ids[2], 1006, false),
+			}
+			digestR2 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 1004},
+				{ID: ids[1].String(), UpdateTime: 1005},
+				{ID: ids[2].String(), UpdateTime: 1000}, // slightly behind
+			}
+			// C is far behind on all objects
+			digestR3 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 4},
+				{ID: ids[1].String(), UpdateTime: 0},
+				{ID: ids[2].String(), UpdateTime: 6},
+			}
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, anyVal).Return(directRe, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+
+		err := finder.CheckConsistency(ctx, All, directR)
+		require.Nil(t, err)
+		require.Equal(t, []string{"C"}, degraded)
+
+		// C is local but no longer serves direct reads
+		item := objects.Replica{ID: ids[0], Object: object(ids[0], 1004)}
+		for _, n := range nodes {
+			f.RClient.On("FetchObject", anyVal, n, cls, shard, ids[0], anyVal, anyVal).Return(item, nil)
+		}
+		for i := 0; i < 5; i++ {
+			_, err = finder.GetOne(ctx, One, shard, ids[0], nil, additional.Properties{})
+			require.Nil(t, err)
+		}
+		f.RClient.AssertNotCalled(t, "FetchObject", anyVal, nodes[2], cls, shard, ids[0], anyVal, anyVal)
+
+		finder.RestoreNode("C")
+		_, err = finder.GetOne(ctx, One, shard, ids[0], nil, additional.Properties{})
+		require.Nil(t, err)
+		f.RClient.AssertCalled(t, "FetchObject", anyVal, nodes[2], cls, shard, ids[0], anyVal, anyVal)
+	})
+
+	t.Run("WithVectors", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A")
+			directR = []*storobj.Object{
+				objectEx(ids[0], 4, shard, "A"),
+				objectEx(ids[1], 5, shard, "A"),
+				objectEx(ids[2], 6, shard, "A"),
+			}
+			directRe = []objects.Replica{
+				replica(ids[0], 4, false),
+				replica(ids[1], 5, false),
+				replica(ids[2], 6, false),
+			}
+			digestR2 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 4},
+				{ID: ids[1].String(), UpdateTime: 5},
+				{ID: ids[2].String(), UpdateTime: 6},
+			}
+			digestR3 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 4},
+				{ID: ids[1].String(), UpdateTime: 2},
+				{ID: ids[2].String(), UpdateTime: 6},
+			}
+		)
+		for i := range directRe {
+			directRe[i].Object.Vector = []float32{float32(i), 1}
+		}
+
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR3, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, anyVal).Return(directRe, nil).
+			Once().
+			RunFn = func(a mock.Arguments) {
+			got := a[4].([]strfmt.UUID)
+			require.ElementsMatch(t, ids, got)
+		}
+		f.RClient.On("OverwriteObjects", anyVal, nodes[2], cls, shard, anyVal).
+			Return(digestR3, nil).
+			Once().
+			RunFn = func(a mock.Arguments) {
+			got := a[4].([]*objects.VObject)
+			require.Len(t, got, 1)
+			require.Equal(t, ids[1], got[0].ID)
+			require.Equal(t, []float32{1, 1}, got[0].Vector)
+		}
+
+		err := finder.CheckConsistency(ctx, All, directR, WithVectors())
+		require.Nil(t, err)
+		for i, x := range directR {
+			require.Equal(t, []float32{float32(i), 1}, x.Vector)
+			require.True(t, x.IsConsistent)
+			require.Equal(t, "A", x.BelongsToNode)
+			require.Equal(t, shard, x.BelongsToShard)
+		}
+	})
+
+	t.Run("WithVectorNodes", func(t *testing.T) {
+		var (
+			f       = newFakeFactory("C1", shard, nodes)
+			finder  = f.newFinder("A", WithVectorNodes(map[string]string{shard: "B"}))
+			directR = []*storobj.Object{
+				objectEx(ids[0], 4, shard, "A"),
+				objectEx(ids[1], 5, shard, "A"),
+			}
+			vectorR = []objects.Replica{
+				replica(ids[0], 4, false),
+				replica(ids[1], 5, false),
+			}
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 4},
+				{ID: ids[1].String(), UpdateTime: 5},
+			}
+		)
+		for i := range vectorR {
+			vectorR[i].Object.Vector = []float32{float32(i), 1}
+		}
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, anyVal).Return(digestR, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[1], cls, shard, anyVal).Return(vectorR, nil)
+
+		err := finder.CheckConsistency(ctx, All, directR, WithVectors())
+		require.Nil(t, err)
+		for i, x := range directR {
+			require.Equal(t, []float32{float32(i), 1}, x.Vector)
+			require.True(t, x.IsConsistent)
+		}
+		f.RClient.AssertNotCalled(t, "FetchObjects", anyVal, nodes[0], cls, shard, anyVal)
+	})
+
 	t.Run("GetMostRecentContent2", func(t *testing.T) {
 		var (
 			f      = newFakeFactory(cls, shard, nodes)
@@ -1392,6 +2762,61 @@ func TestRepairerCheckConsistencyAll(t *testing.T) {
 		require.Nil(t, err)
 		require.Equal(t, want, xs)
 	})
+
+	t.Run("Winners", func(t *testing.T) {
+		// different objects win on different nodes
+		var (
+			f      = newFakeFactory(cls, shard, nodes)
+			finder = f.newFinder("A")
+			ids    = []strfmt.UUID{"1", "2", "3", "4", "5"}
+			xs     = []*storobj.Object{
+				objectEx(ids[0], 1, shard, "A"),
+				objectEx(ids[1], 1, shard, "A"),
+				objectEx(ids[2], 2, shard, "A"),
+				objectEx(ids[3], 4, shard, "A"), // latest
+				objectEx(ids[4], 2, shard, "A"),
+			}
+			digestR2 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 2}, // latest
+				{ID: ids[1].String(), UpdateTime: 2}, // latest
+				{ID: ids[2].String(), UpdateTime: 1},
+				{ID: ids[3].String(), UpdateTime: 1},
+				{ID: ids[4].String(), UpdateTime: 1},
+			}
+			digestR3 = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 1},
+				{ID: ids[1].String(), UpdateTime: 1},
+				{ID: ids[2].String(), UpdateTime: 3}, // latest
+				{ID: ids[3].String(), UpdateTime: 1},
+				{ID: ids[4].String(), UpdateTime: 3}, // latest
+			}
+			directRe = []objects.Replica{replica(ids[3], 4, false)}
+			directR2 = []objects.Replica{replica(ids[0], 2, false), replica(ids[1], 2, false)}
+			directR3 = []objects.Replica{replica(ids[2], 3, false), replica(ids[4], 3, false)}
+			winners  map[strfmt.UUID]string
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR3, nil)
+		f.RClient.On("FetchObjects", anyVal, nodes[0], cls, shard, anyVal).Return(directRe, nil).Once()
+		f.RClient.On("FetchObjects", anyVal, nodes[1], cls, shard, anyVal).Return(directR2, nil).Once()
+		f.RClient.On("FetchObjects", anyVal, nodes[2], cls, shard, anyVal).Return(directR3, nil).Once()
+		for _, node := range nodes {
+			f.RClient.On("OverwriteObjects", anyVal, node, cls, shard, anyVal).
+				Return([]RepairResponse{}, nil).
+				Once()
+		}
+
+		err := finder.CheckConsistency(ctx, All, xs, WithWinners(&winners))
+		require.Nil(t, err)
+		want := map[strfmt.UUID]string{
+			ids[0]: "B",
+			ids[1]: "B",
+			ids[2]: "C",
+			ids[3]: "A",
+			ids[4]: "C",
+		}
+		require.Equal(t, want, winners)
+	})
 }
 
 func TestRepairerCheckConsistencyQuorum(t *testing.T) {
@@ -71,6 +71,7 @@ func NewReplicator(className string,
 	deletionStrategy string,
 	client Client,
 	l logrus.FieldLogger,
+	opts ...FinderOption,
 ) *Replicator {
 	resolver := &resolver{
 		Schema:       stateGetter,
@@ -85,7 +86,7 @@ func NewReplicator(className string,
 		resolver:    resolver,
 		log:         l,
 		Finder: NewFinder(className, resolver, client, l,
-			defaultPullBackOffInitialInterval, defaultPullBackOffMaxElapsedTime, deletionStrategy),
+			defaultPullBackOffInitialInterval, defaultPullBackOffMaxElapsedTime, deletionStrategy, opts...),
 	}
 }
 
@@ -714,7 +714,7 @@ func (f fakeFactory) newReplicator() *Replicator {
 		}{f.RClient, f.WClient}, f.log)
 }
 
-func (f fakeFactory) newFinder(thisNode string) *Finder {
+func (f fakeFactory) newFinder(thisNode string, opts ...FinderOption) *Finder {
 	nodeResolver := newFakeNodeResolver(f.Nodes)
 	resolver := &resolver{
 		Schema:       newFakeShardingState(thisNode, f.Shard2replicas, nodeResolver),
@@ -723,7 +723,7 @@ func (f fakeFactory) newFinder(thisNode string) *Finder {
 		NodeName:     thisNode,
 	}
 	return NewFinder(f.CLS, resolver, f.RClient, f.log,
-		time.Microsecond*1, time.Millisecond*128, models.ReplicationConfigDeletionStrategyNoAutomatedResolution)
+		time.Microsecond*1, time.Millisecond*128, models.ReplicationConfigDeletionStrategyNoAutomatedResolution, opts...)
 }
 
 func (f fakeFactory) assertLogContains(t *testing.T, key string, xs ...string) {
@@ -13,6 +13,9 @@ package replica
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
@@ -26,6 +29,14 @@ const (
 	All    ConsistencyLevel = "ALL"
 )
 
+// Fraction returns a consistency level requiring ceil(f*N) of the N replicas
+// of a shard, N being determined when the level is resolved for a read.
+// It lets the same policy be applied to shards with different replication
+// factors. f is expected to be in (0, 1], other values behave like One.
+func Fraction(f float64) ConsistencyLevel {
+	return ConsistencyLevel(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
 // cLevel returns min number of replicas to fulfill the consistency level
 func cLevel(l ConsistencyLevel, n int) int {
 	switch l {
@@ -34,6 +45,10 @@ func cLevel(l ConsistencyLevel, n int) int {
 	case Quorum:
 		return n/2 + 1
 	default:
+		if f, err := strconv.ParseFloat(string(l), 64); err == nil && f > 0 && f <= 1 {
+			// the tolerance absorbs rounding errors such as 0.1*30 = 3.0000000000000004
+			return max(1, int(math.Ceil(f*float64(n)-1e-9)))
+		}
 		return 1
 	}
 }
@@ -56,7 +71,7 @@ func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate
 	res.CLevel = cl
 	m, err := r.Schema.ResolveParentNodes(r.Class, shardName)
 	if err != nil {
-		return res, err
+		return res, fmt.Errorf("%w: %w", errUnknownShard, err)
 	}
 	res.NodeMap = m
 	// count number of valid addr
@@ -78,7 +93,9 @@ func (r *resolver) State(shardName string, cl ConsistencyLevel, directCandidate
 		res.Hosts = append(res.Hosts, addr)
 	}
 	for name, addr := range m {
-		if name != "" && addr != "" && name != directCandidate {
+		// during membership changes two names may resolve to the same host, which
+		// must be queried once so that it does not count twice toward the level
+		if name != "" && addr != "" && name != directCandidate && !slices.Contains(res.Hosts, addr) {
 			res.Hosts = append(res.Hosts, addr)
 		}
 	}
@@ -99,11 +116,33 @@ type rState struct {
 	NodeMap map[string]string
 }
 
+// nodeName returns the name of the node with address host, or host if unknown
+func (r *rState) nodeName(host string) string {
+	for name, addr := range r.NodeMap {
+		if addr == host {
+			return name
+		}
+	}
+	return host
+}
+
 // Len returns the number of replicas
 func (r *rState) Len() int {
 	return len(r.NodeMap)
 }
 
+// ToleratedFailures returns the number of replicas which may fail
+// without failing a read at the resolved level
+func (r *rState) ToleratedFailures() int {
+	return r.Len() - r.Level
+}
+
+// readError returns errRead detailing the failure tolerance of the read
+func (r *rState) readError() error {
+	return fmt.Errorf("%w: more than %d of %d replicas failed at level %d",
+		errRead, r.ToleratedFailures(), r.Len(), r.Level)
+}
+
 // ConsistencyLevel returns consistency level if it is satisfied
 func (r *rState) ConsistencyLevel(l ConsistencyLevel) (int, error) {
 	level := cLevel(l, r.Len())
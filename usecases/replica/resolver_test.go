@@ -111,3 +111,39 @@ func TestResolver(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestFractionalConsistencyLevel(t *testing.T) {
+	for _, tc := range []struct {
+		f    float64
+		n    int
+		want int
+	}{
+		{0.6, 1, 1},
+		{0.6, 3, 2},
+		{0.6, 5, 3},
+		{0.6, 10, 6},
+		{0.5, 4, 2},
+		{0.5, 5, 3},
+		{0.1, 30, 3},
+		{0.01, 3, 1},
+		{1, 7, 7},
+		{0, 3, 1},
+		{1.5, 3, 1},
+	} {
+		assert.Equal(t, tc.want, cLevel(Fraction(tc.f), tc.n), "fraction %v of %d", tc.f, tc.n)
+	}
+
+	t.Run("State", func(t *testing.T) {
+		ss := map[string][]string{"S1": {"A", "B", "C"}}
+		nr := newFakeNodeResolver([]string{"A", "B", "C"})
+		r := resolver{
+			nodeResolver: nr,
+			Class:        "C",
+			NodeName:     "A",
+			Schema:       newFakeShardingState("A", ss, nr),
+		}
+		got, err := r.State("S1", Fraction(0.6), "")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, got.Level)
+	})
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// RPCStats is the number of requests of each kind sent to replicas by a single call
+type RPCStats struct {
+	FetchObject      int
+	FetchObjects     int
+	DigestObjects    int
+	OverwriteObjects int
+}
+
+// Total returns the number of requests of all kinds
+func (s RPCStats) Total() int {
+	return s.FetchObject + s.FetchObjects + s.DigestObjects + s.OverwriteObjects
+}
+
+type rpcCounterKey struct{}
+
+// rpcCounter counts the requests sent with a context carrying it
+type rpcCounter struct {
+	fetchObject, fetchObjects, digestObjects, overwriteObjects atomic.Int64
+}
+
+// withRPCCounter returns a copy of ctx counting the requests sent with it
+func withRPCCounter(ctx context.Context) (context.Context, *rpcCounter) {
+	c := &rpcCounter{}
+	return context.WithValue(ctx, rpcCounterKey{}, c), c
+}
+
+// countRPC counts request op if ctx carries a counter
+func countRPC(ctx context.Context, op string) {
+	c, _ := ctx.Value(rpcCounterKey{}).(*rpcCounter)
+	if c == nil {
+		return
+	}
+	switch op {
+	case "FetchObject":
+		c.fetchObject.Add(1)
+	case "FetchObjects":
+		c.fetchObjects.Add(1)
+	case "DigestObjects":
+		c.digestObjects.Add(1)
+	case "OverwriteObjects":
+		c.overwriteObjects.Add(1)
+	}
+}
+
+func (c *rpcCounter) stats() RPCStats {
+	return RPCStats{
+		FetchObject:      int(c.fetchObject.Load()),
+		FetchObjects:     int(c.fetchObjects.Load()),
+		DigestObjects:    int(c.digestObjects.Load()),
+		OverwriteObjects: int(c.overwriteObjects.Load()),
+	}
+}
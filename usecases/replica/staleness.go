@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import "sync"
+
+// stalenessBreaker flags replicas lagging far behind the freshest one as degraded.
+// Degraded replicas are not used for direct reads unless explicitly requested.
+type stalenessBreaker struct {
+	// maxLag is the maximum update time difference to the freshest replica
+	maxLag int64
+	// maxObjects is the number of objects lagging more than maxLag in a
+	// single read which makes a replica degraded
+	maxObjects int
+	onDegraded func(node string)
+
+	mu       sync.Mutex
+	degraded map[string]struct{} // host names
+}
+
+// observe records that host lags more than maxLag on n objects.
+// It flags host as degraded if n reaches maxObjects.
+func (b *stalenessBreaker) observe(st rState, host string, n int) {
+	if b == nil || n == 0 || n < b.maxObjects {
+		return
+	}
+	b.mu.Lock()
+	_, ok := b.degraded[host]
+	b.degraded[host] = struct{}{}
+	b.mu.Unlock()
+	if !ok && b.onDegraded != nil {
+		b.onDegraded(st.nodeName(host))
+	}
+}
+
+// isDegraded returns true if host has been flagged as degraded
+func (b *stalenessBreaker) isDegraded(host string) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.degraded[host]
+	return ok
+}
+
+// restore clears the degraded flag of host
+func (b *stalenessBreaker) restore(host string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.degraded, host)
+}
+
+// healthyFirst returns hosts with degraded hosts moved to the end,
+// keeping the relative order of the others
+func (b *stalenessBreaker) healthyFirst(hosts []string) []string {
+	if b == nil {
+		return hosts
+	}
+	xs := make([]string, 0, len(hosts))
+	var degraded []string
+	for _, h := range hosts {
+		if b.isDegraded(h) {
+			degraded = append(degraded, h)
+		} else {
+			xs = append(xs, h)
+		}
+	}
+	return append(xs, degraded...)
+}
+
+// healthyFirst returns the hosts of st with the hosts of nodes which health
+// reports as unhealthy moved to the end, keeping the relative order of the others
+func healthyFirst(health NodeHealth, st rState) []string {
+	if health == nil {
+		return st.Hosts
+	}
+	xs := make([]string, 0, len(st.Hosts))
+	var unhealthy []string
+	for _, h := range st.Hosts {
+		if health.Healthy(st.nodeName(h)) {
+			xs = append(xs, h)
+		} else {
+			unhealthy = append(unhealthy, h)
+		}
+	}
+	return append(xs, unhealthy...)
+}
+
+// stalestTracker records which replica was the stalest in each of the last reads
+// with diverging replicas, and reports replicas which are the stalest too often
+type stalestTracker struct {
+	// window is the number of reads considered
+	window int
+	// threshold is the fraction of the window which makes a replica suspicious
+	threshold float64
+	onStalest func(node string, frequency float64)
+
+	mu      sync.Mutex
+	recent  []string            // ring buffer of host names
+	next    int                 // oldest entry once recent is full
+	counts  map[string]int      // host names
+	flagged map[string]struct{} // host names reported and still above threshold
+}
+
+// observe records host as the stalest replica of a read. onStalest is called once
+// host has been the stalest in threshold of the window, and again only after its
+// frequency has dropped below threshold in between.
+func (t *stalestTracker) observe(st rState, host string) {
+	if t == nil || host == "" {
+		return
+	}
+	t.mu.Lock()
+	if len(t.recent) < t.window {
+		t.recent = append(t.recent, host)
+	} else {
+		t.counts[t.recent[t.next]]--
+		t.recent[t.next] = host
+		t.next = (t.next + 1) % t.window
+	}
+	t.counts[host]++
+	for h := range t.flagged {
+		if float64(t.counts[h]) < t.threshold*float64(t.window) {
+			delete(t.flagged, h)
+		}
+	}
+	freq := float64(t.counts[host]) / float64(t.window)
+	_, ok := t.flagged[host]
+	fire := !ok && len(t.recent) == t.window && freq >= t.threshold
+	if fire {
+		t.flagged[host] = struct{}{}
+	}
+	t.mu.Unlock()
+	if fire && t.onStalest != nil {
+		t.onStalest(st.nodeName(host), freq)
+	}
+}
+
+// stalest returns the host with the largest positive lag,
+// or an empty string if there is none or several
+func stalest(hosts []string, lags []int64) string {
+	host, most, ties := "", int64(0), false
+	for i, lag := range lags {
+		switch {
+		case lag > most:
+			host, most, ties = hosts[i], lag, false
+		case lag == most && lag > 0:
+			ties = true
+		}
+	}
+	if ties {
+		return ""
+	}
+	return host
+}
@@ -160,6 +160,7 @@ type RepairResponse struct {
 	UpdateTime int64  // sender's current update time
 	Err        string
 	Deleted    bool
+	Generation uint64 // sender's generation of the shard, 0 if unknown
 }
 
 func fromReplicas(xs []objects.Replica) []*storobj.Object {
@@ -170,6 +171,11 @@ func fromReplicas(xs []objects.Replica) []*storobj.Object {
 	return rs
 }
 
+// hasVectors returns true if x carries at least one vector
+func hasVectors(x *storobj.Object) bool {
+	return x == nil || len(x.Vector) > 0 || len(x.Vectors) > 0 || len(x.MultiVectors) > 0
+}
+
 type DigestObjectsInTokenRangeReq struct {
 	InitialToken uint64 `json:"initialToken,omitempty"`
 	FinalToken   uint64 `json:"finalToken,omitempty"`
@@ -231,9 +237,52 @@ type rClient interface {
 		discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
 }
 
+// LocalReader reads replicas of shards held by this node
+type LocalReader interface {
+	FetchObject(ctx context.Context, shardName string, id strfmt.UUID) (objects.Replica, error)
+	DigestObjects(ctx context.Context, shardName string, ids []strfmt.UUID) ([]RepairResponse, error)
+}
+
+// TopologyReader is optionally implemented by replication clients which can ask
+// a node which nodes it believes replicate a shard
+type TopologyReader interface {
+	ShardReplicas(ctx context.Context, host, index, shard string) ([]string, error)
+}
+
+// LatencyObserver records the latency of requests sent to replicas
+type LatencyObserver interface {
+	// ObserveLatency records the duration d of operation op sent to host
+	ObserveLatency(host, op string, d time.Duration)
+}
+
+// NodeHealth reports the health of nodes, e.g. as signaled by heartbeats
+type NodeHealth interface {
+	// Healthy returns false if node is struggling and should be avoided
+	Healthy(node string) bool
+}
+
 // finderClient extends RClient with consistency checks
 type finderClient struct {
-	cl rClient
+	cl      rClient
+	latency LatencyObserver
+	// repairLimit caps the bytes of overwrites sent per shard, nil disables it
+	repairLimit *repairLimiter
+}
+
+// observe records the latency of operation op sent to host since start
+// and counts it if ctx carries an RPC counter
+func (fc finderClient) observe(ctx context.Context, host, op string, start time.Time) {
+	countRPC(ctx, op)
+	if fc.latency == nil {
+		return
+	}
+	if o, ok := fc.latency.(LabeledLatencyObserver); ok {
+		if labels := labelsFrom(ctx); len(labels) > 0 {
+			o.ObserveLabeledLatency(host, op, time.Since(start), labels)
+			return
+		}
+	}
+	fc.latency.ObserveLatency(host, op, time.Since(start))
 }
 
 // FullRead reads full object
@@ -244,24 +293,31 @@ func (fc finderClient) FullRead(ctx context.Context,
 	additional additional.Properties,
 	numRetries int,
 ) (objects.Replica, error) {
+	defer fc.observe(ctx, host, "FetchObject", time.Now())
 	return fc.cl.FetchObject(ctx, host, index, shard, id, props, additional, numRetries)
 }
 
 func (fc finderClient) HashTreeLevel(ctx context.Context,
 	host, index, shard string, level int, discriminant *hashtree.Bitset,
 ) (digests []hashtree.Digest, err error) {
+	defer fc.observe(ctx, host, "HashTreeLevel", time.Now())
 	return fc.cl.HashTreeLevel(ctx, host, index, shard, level, discriminant)
 }
 
-// DigestReads reads digests of all specified objects
+// DigestReads reads digests of all specified objects.
+// An empty reply is rejected with errEmptyReply, see batchReply.aligned.
 func (fc finderClient) DigestReads(ctx context.Context,
 	host, index, shard string,
 	ids []strfmt.UUID, numRetries int,
 ) ([]RepairResponse, error) {
 	n := len(ids)
+	defer fc.observe(ctx, host, "DigestObjects", time.Now())
 	rs, err := fc.cl.DigestObjects(ctx, host, index, shard, ids, numRetries)
 	if err == nil && len(rs) != n {
 		err = fmt.Errorf("malformed digest read response: length expected %d got %d", n, len(rs))
+		if len(rs) == 0 {
+			err = fmt.Errorf("%w: %w", errEmptyReply, err)
+		}
 	}
 	return rs, err
 }
@@ -270,18 +326,24 @@ func (fc finderClient) DigestObjectsInTokenRange(ctx context.Context,
 	host, index, shard string,
 	initialToken, finalToken uint64, limit int,
 ) ([]RepairResponse, uint64, error) {
+	defer fc.observe(ctx, host, "DigestObjectsInTokenRange", time.Now())
 	return fc.cl.DigestObjectsInTokenRange(ctx, host, index, shard, initialToken, finalToken, limit)
 }
 
-// FullReads read full objects
+// FullReads read full objects.
+// An empty reply is rejected with errEmptyReply, see batchReply.aligned.
 func (fc finderClient) FullReads(ctx context.Context,
 	host, index, shard string,
 	ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
 	n := len(ids)
+	defer fc.observe(ctx, host, "FetchObjects", time.Now())
 	rs, err := fc.cl.FetchObjects(ctx, host, index, shard, ids)
 	if m := len(rs); err == nil && n != m {
 		err = fmt.Errorf("malformed full read response: length expected %d got %d", n, m)
+		if m == 0 {
+			err = fmt.Errorf("%w: %w", errEmptyReply, err)
+		}
 	}
 	return rs, err
 }
@@ -291,11 +353,16 @@ func (fc finderClient) Overwrite(ctx context.Context,
 	host, index, shard string,
 	xs []*objects.VObject,
 ) ([]RepairResponse, error) {
+	if fc.repairLimit != nil && !fc.repairLimit.allow(shard, payloadSize(xs)) {
+		return nil, errRepairDeferred
+	}
+	defer fc.observe(ctx, host, "OverwriteObjects", time.Now())
 	return fc.cl.OverwriteObjects(ctx, host, index, shard, xs)
 }
 
 func (fc finderClient) FindUUIDs(ctx context.Context,
 	host, class, shard string, filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
+	defer fc.observe(ctx, host, "FindUUIDs", time.Now())
 	return fc.cl.FindUUIDs(ctx, host, class, shard, filters)
 }
@@ -16,8 +16,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"golang.org/x/net/context"
 )
 
@@ -63,3 +65,33 @@ func TestReplicationErrorStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestFinderClientEmptyReplies(t *testing.T) {
+	var (
+		ctx = context.Background()
+		ids = []strfmt.UUID{"1", "2"}
+		rc  = &fakeRClient{}
+		fc  = finderClient{cl: rc}
+	)
+	rc.On("DigestObjects", anyVal, "A", "C1", "S1", ids).Return([]RepairResponse{}, nil)
+	rc.On("FetchObjects", anyVal, "A", "C1", "S1", ids).Return([]objects.Replica{}, nil)
+	rc.On("DigestObjects", anyVal, "B", "C1", "S1", ids).Return([]RepairResponse{{ID: "1"}}, nil)
+	rc.On("FetchObjects", anyVal, "B", "C1", "S1", ids).Return([]objects.Replica{{ID: "1"}}, nil)
+
+	// empty replies are malformed too, but can be told apart by the callers accepting them
+	_, err := fc.DigestReads(ctx, "A", "C1", "S1", ids, 0)
+	assert.ErrorIs(t, err, errEmptyReply)
+	assert.ErrorContains(t, err, "malformed digest read response")
+
+	_, err = fc.FullReads(ctx, "A", "C1", "S1", ids)
+	assert.ErrorIs(t, err, errEmptyReply)
+	assert.ErrorContains(t, err, "malformed full read response")
+
+	_, err = fc.DigestReads(ctx, "B", "C1", "S1", ids, 0)
+	assert.ErrorContains(t, err, "malformed digest read response")
+	assert.NotErrorIs(t, err, errEmptyReply)
+
+	_, err = fc.FullReads(ctx, "B", "C1", "S1", ids)
+	assert.ErrorContains(t, err, "malformed full read response")
+	assert.NotErrorIs(t, err, errEmptyReply)
+}